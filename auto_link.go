@@ -48,6 +48,7 @@ func (t *Tree) parseGFMAutoEmailLink0(node Node) {
 	if 0 >= bytes.Index(tokens, []byte("@")) {
 		return
 	}
+	prev := node.Previous()
 
 	var i, j, k, atIndex int
 	var token byte
@@ -116,7 +117,7 @@ loopPart:
 			// 如果以 . 结尾则剔除该 .
 			lastIndex := len(group) - 1
 			group = group[:lastIndex]
-			link := &Link{&BaseNode{typ: NodeLink}, append(items("mailto:"), group...), nil}
+			link := &Link{&BaseNode{typ: NodeLink}, append(items("mailto:"), group...), nil, nil, 0}
 			link.AppendChild(link, &Text{tokens: group})
 			node.InsertBefore(node, link)
 			// . 作为文本节点插入
@@ -129,13 +130,14 @@ loopPart:
 			continue loopPart
 		} else {
 			// 以字母或者数字结尾
-			link := &Link{&BaseNode{typ: NodeLink}, append(items("mailto:"), group...), nil}
+			link := &Link{&BaseNode{typ: NodeLink}, append(items("mailto:"), group...), nil, nil, 0}
 			link.AppendChild(link, &Text{tokens: group})
 			node.InsertBefore(node, link)
 		}
 	}
 
 	// 处理完后传入的文本节点 node 已经被拆分为多个节点，所以可以移除自身
+	splitTextPos(node, prev)
 	node.Unlink()
 	return
 }
@@ -155,6 +157,7 @@ func (t *Tree) parseGFMAutoLink0(node Node) {
 	if 8 > length { // 太短的情况肯定不可能有链接
 		return
 	}
+	prev := node.Previous()
 
 	var token byte
 	var consumed = make(items, 0, 256)
@@ -317,7 +320,7 @@ func (t *Tree) parseGFMAutoLink0(node Node) {
 		addr = append(addr, domain...)
 		addr = append(addr, path...)
 
-		link := &Link{&BaseNode{typ: NodeLink}, encodeDestination(dest), nil}
+		link := &Link{&BaseNode{typ: NodeLink}, encodeDestination(dest), nil, nil, 0}
 		link.AppendChild(link, &Text{tokens: addr})
 		node.InsertBefore(node, link)
 	}
@@ -328,6 +331,7 @@ func (t *Tree) parseGFMAutoLink0(node Node) {
 	}
 
 	// 处理完后传入的文本节点 node 已经被拆分为多个节点，所以可以移除自身
+	splitTextPos(node, prev)
 	node.Unlink()
 	return
 }
@@ -434,9 +438,11 @@ func (t *Tree) parseAutoEmailLink(ctx *InlineContext) (ret Node) {
 		return nil
 	}
 
+	text := &Text{tokens: toItems(dest)}
+	ctx.setPos(text, ctx.pos+1, ctx.pos+1+len(dest))
 	ctx.pos += passed + 1
-	ret = &Link{&BaseNode{typ: NodeLink}, append(items("mailto:"), dest...), nil}
-	ret.AppendChild(ret, &Text{tokens: toItems(dest)})
+	ret = &Link{&BaseNode{typ: NodeLink}, append(items("mailto:"), dest...), nil, nil, 0}
+	ret.AppendChild(ret, text)
 
 	return
 }
@@ -466,13 +472,15 @@ func (t *Tree) parseAutolink(ctx *InlineContext) (ret Node) {
 		return nil
 	}
 
-	ret = &Link{&BaseNode{typ: NodeLink}, encodeDestination(dest), nil}
+	ret = &Link{&BaseNode{typ: NodeLink}, encodeDestination(dest), nil, nil, 0}
 	if itemGreater != ctx.tokens[i] {
 		return nil
 	}
 
+	text := &Text{tokens: dest}
+	ctx.setPos(text, ctx.pos+1, i)
 	ctx.pos = 1 + i
-	ret.AppendChild(ret, &Text{tokens: dest})
+	ret.AppendChild(ret, text)
 
 	return
 }
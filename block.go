@@ -12,22 +12,29 @@
 
 package lute
 
-import "bytes"
+import (
+	"bytes"
+	"unicode"
+)
 
 // parseBlocks 解析并生成块级节点。
 func (t *Tree) parseBlocks() {
 	t.context.tip = t.Root
 	t.context.linkRefDef = map[string]*Link{}
+	t.context.footnotesDef = map[string]*FootnotesDef{}
 	for line := t.lexer.nextLine(); nil != line; line = t.lexer.nextLine() {
 		t.incorporateLine(line)
 	}
 	for nil != t.context.tip {
-		t.context.finalize(t.context.tip)
+		t.context.finalize(t.context.tip, t.context.lineNum)
 	}
 }
 
 // incorporateLine 处理文本行 line 并把生成的块级节点挂到树上。
 func (t *Tree) incorporateLine(line items) {
+	t.context.lastLineLen, t.context.lastLineOffset = lineContentLen(t.context.currentLine), t.context.lineOffset
+	t.context.lastLineIsBlank = t.context.currentLine.isBlankLine()
+	t.context.lineNum, t.context.lineOffset = t.lexer.lineNum, t.lexer.lineOffset
 	t.context.oldtip = t.context.tip
 	t.context.offset = 0
 	t.context.column = 0
@@ -73,35 +80,36 @@ func (t *Tree) incorporateLine(line items) {
 		// 如果不由潜在的节点标记开头 ^[#`~*+_=<>0-9-]，则说明不用继续迭代生成子节点
 		// 这里仅做简单判断的话可以略微提升一些性能
 		maybeMarker := t.context.currentLine[t.context.nextNonspace]
-		if !t.context.indented &&
+		exts := t.context.option.blockExts[maybeMarker]
+		if !t.context.indented && nil == exts &&
 			itemHyphen != maybeMarker && itemAsterisk != maybeMarker && itemPlus != maybeMarker && // 无序列表
 			!isDigit(maybeMarker) && // 有序列表
 			itemBacktick != maybeMarker && itemTilde != maybeMarker && // 代码块
 			itemCrosshatch != maybeMarker && // ATX 标题
 			itemGreater != maybeMarker && // 块引用
 			itemLess != maybeMarker && // HTML 块
-			itemUnderscore != maybeMarker && itemEqual != maybeMarker { // Setext 标题
+			itemUnderscore != maybeMarker && itemEqual != maybeMarker && // Setext 标题
+			itemOpenBracket != maybeMarker && // 脚注定义
+			itemDollar != maybeMarker { // 公式块
 			t.context.advanceNextNonspace()
 			break
 		}
 
-		// 逐个尝试是否可以起始一个块级节点
-		var i = 0
-		for i < startsLen {
-			var res = blockStarts[i](t, container)
-			if res == 1 { // 匹配到容器块，继续迭代下降过程
-				container = t.context.tip
-				break
-			} else if res == 2 { // 匹配到叶子块，跳出迭代下降过程
-				container = t.context.tip
-				matchedLeaf = true
-				break
-			} else { // 没有匹配到，继续用下一个起始块模式进行匹配
-				i++
-			}
+		// 先尝试扩展，然后逐个尝试是否可以起始一个块级节点
+		var res = 0
+		if nil != exts {
+			res = t.startBlockExt(container, exts)
+		}
+		for i := 0; 0 == res && i < startsLen; i++ {
+			res = blockStarts[i](t, container)
 		}
 
-		if i == startsLen { // nothing matched
+		if res == 1 { // 匹配到容器块，继续迭代下降过程
+			container = t.context.tip
+		} else if res == 2 { // 匹配到叶子块，跳出迭代下降过程
+			container = t.context.tip
+			matchedLeaf = true
+		} else { // nothing matched
 			t.context.advanceNextNonspace()
 			break
 		}
@@ -141,7 +149,7 @@ func (t *Tree) incorporateLine(line items) {
 				html := container.(*HTMLBlock)
 				if html.hType >= 1 && html.hType <= 5 {
 					if t.isHTMLBlockClose(t.context.currentLine[t.context.offset:], html.hType) {
-						t.context.finalize(container)
+						t.context.finalize(container, t.context.lineNum)
 					}
 				}
 			}
@@ -162,6 +170,19 @@ type blockStartFunc func(t *Tree, container Node) int
 // 1：匹配到块容器，需要继续迭代下降
 // 2：匹配到叶子块
 var blockStarts = []blockStartFunc{
+	// 判断元数据块（--- +++）是否开始
+	func(t *Tree, container Node) int {
+		if t.context.option.FrontMatter {
+			if frontMatter := t.parseFrontMatter(); nil != frontMatter {
+				t.context.closeUnmatchedBlocks()
+				t.context.addChild(frontMatter)
+				t.context.advanceOffset(3, false)
+				return 2
+			}
+		}
+		return 0
+	},
+
 	// 判断块引用（>）是否开始
 	func(t *Tree, container Node) int {
 		if !t.context.indented {
@@ -212,6 +233,20 @@ var blockStarts = []blockStartFunc{
 		return 0
 	},
 
+	// 判断公式块（$$）是否开始
+	func(t *Tree, container Node) int {
+		if t.context.option.Math && !t.context.indented {
+			if mathBlock := t.parseMathBlock(); nil != mathBlock {
+				t.context.closeUnmatchedBlocks()
+				t.context.addChild(mathBlock)
+				t.context.advanceNextNonspace()
+				t.context.advanceOffset(2, false)
+				return 2
+			}
+		}
+		return 0
+	},
+
 	// 判断 HTML 块（<）是否开始
 	func(t *Tree, container Node) int {
 		if !t.context.indented && t.context.currentLine.peek(t.context.nextNonspace) == itemLess {
@@ -225,14 +260,28 @@ var blockStarts = []blockStartFunc{
 		return 0
 	},
 
+	// 判断脚注定义（[^label]:）是否开始
+	func(t *Tree, container Node) int {
+		if t.context.option.Footnotes && !t.context.indented {
+			if footnotesDef := t.parseFootnotesDef(); nil != footnotesDef {
+				t.context.closeUnmatchedBlocks()
+				t.context.addChild(footnotesDef)
+				return 1
+			}
+		}
+		return 0
+	},
+
 	// 判断 Setext 标题（- =）是否开始
 	func(t *Tree, container Node) int {
 		if !t.context.indented && container.Type() == NodeParagraph {
 			if heading := t.parseSetextHeading(); nil != heading {
 				t.context.closeUnmatchedBlocks()
+				paragraph := container.(*Paragraph)
 				// 解析链接引用定义
 				for tokens := container.Tokens(); 0 < len(tokens) && itemOpenBracket == tokens[0]; tokens = container.Tokens() {
 					if remains := t.context.parseLinkRefDef(tokens); nil != remains {
+						paragraph.lines.trim(len(tokens) - len(remains))
 						container.SetTokens(remains)
 					} else {
 						break
@@ -240,7 +289,10 @@ var blockStarts = []blockStartFunc{
 				}
 
 				if value := container.Tokens(); 0 < len(value) {
+					paragraph.lines.trim(len(value) - len(bytes.TrimLeftFunc(value, unicode.IsSpace)))
 					heading.tokens = bytes.TrimSpace(value)
+					heading.lines = paragraph.lines
+					heading.pos.StartLine, heading.pos.StartColumn, heading.pos.StartOffset = heading.lines.locate(0)
 					container.InsertAfter(container, heading)
 					container.Unlink()
 					t.context.tip = heading
@@ -319,5 +371,12 @@ func (t *Tree) addLine() {
 			t.context.tip.AppendTokens(items{itemSpace})
 		}
 	}
+	if paragraph, ok := t.context.tip.(*Paragraph); ok {
+		// 记录行片段，用于计算段落中行级节点的位置
+		if nil == paragraph.lines {
+			paragraph.lines = &lineMap{}
+		}
+		paragraph.lines.add(len(paragraph.tokens), t.context.lineNum, t.context.offset+1, t.context.lineOffset+t.context.offset)
+	}
 	t.context.tip.AppendTokens(t.context.currentLine[t.context.offset:])
 }
@@ -0,0 +1,184 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"strconv"
+	"sync"
+)
+
+// BlockStartFunc 描述了块级扩展起始判断函数签名，和内置的块起始判断一样返回：
+// 0：不匹配
+// 1：匹配到块容器，需要继续迭代下降
+// 2：匹配到叶子块
+// 匹配时需要先调用 context.CloseUnmatchedBlocks 再通过 context.AddChild 添加节点，并移动 offset 跳过块标记。
+// 扩展节点的后续行处理、最终化以及包含关系判断通过节点自身实现的 Continue、Finalize、CanContain 和 AcceptLines 完成。
+type BlockStartFunc func(context *Context, container Node) int
+
+// BlockExt 描述了块级语法扩展。
+type BlockExt struct {
+	Trigger byte           // 触发字符，即块标记的第一个字符
+	Start   BlockStartFunc // 起始判断函数
+}
+
+// startBlockExt 使用触发字符对应的扩展 exts 尝试起始一个块级节点，返回值同 BlockStartFunc。
+func (t *Tree) startBlockExt(container Node, exts []*BlockExt) int {
+	for _, ext := range exts {
+		if res := ext.Start(t.context, container); 0 != res {
+			return res
+		}
+	}
+	return 0
+}
+
+// NodeFactory 描述了扩展节点构造函数，base 为已经设置好类型、位置和内容的基础节点，返回包装了 base 的扩展节点。
+// 从 JSON 还原语法树时使用该函数构造扩展节点，这样扩展渲染函数中就可以断言得到扩展节点自己的结构。
+type NodeFactory func(base *BaseNode) Node
+
+var (
+	nodeTypeLock      sync.RWMutex
+	nodeTypeNext      = 1024                  // 扩展节点类型从 1024 开始分配，避免和内置节点类型冲突
+	nodeTypeFactories = map[int]NodeFactory{} // 扩展节点类型对应的构造函数
+)
+
+// NewNodeType 分配一个新的节点类型，name 为节点类型名称（在 JSON 中使用），factory 为扩展节点构造函数，
+// 为 nil 时直接使用基础节点。该函数应该在初始化阶段调用。
+// 节点类型名称必须唯一，name 和内置或者已经分配过的节点类型名称重复时会 panic；name 为空时自动生成一个不重复的名称。
+func NewNodeType(name string, factory NodeFactory) (ret int) {
+	nodeTypeLock.Lock()
+	defer nodeTypeLock.Unlock()
+
+	if "" != name && nodeTypeNameUsed(name) {
+		panic("duplicated node type name [" + name + "]")
+	}
+
+	ret = nodeTypeNext
+	nodeTypeNext++
+	if "" == name {
+		name = "Node" + strconv.Itoa(ret)
+		for nodeTypeNameUsed(name) {
+			name += "_"
+		}
+	}
+	nodeTypeNames[ret] = name
+	if nil != factory {
+		nodeTypeFactories[ret] = factory
+	}
+	return
+}
+
+// nodeTypeNameUsed 判断节点类型名称 name 是否已经被使用，调用方需要持有 nodeTypeLock。
+func nodeTypeNameUsed(name string) bool {
+	for _, typeName := range nodeTypeNames {
+		if typeName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeTypeName 返回节点类型 typ 的名称。
+func nodeTypeName(typ int) string {
+	nodeTypeLock.RLock()
+	defer nodeTypeLock.RUnlock()
+
+	return nodeTypeNames[typ]
+}
+
+// nodeTypes 返回所有节点类型名称到节点类型的映射。
+func nodeTypes() (ret map[string]int) {
+	nodeTypeLock.RLock()
+	defer nodeTypeLock.RUnlock()
+
+	ret = make(map[string]int, len(nodeTypeNames))
+	for typ, name := range nodeTypeNames {
+		ret[name] = typ
+	}
+	return
+}
+
+// nodeTypeFactory 返回扩展节点类型 typ 的构造函数，没有注册时返回 nil。
+func nodeTypeFactory(typ int) NodeFactory {
+	nodeTypeLock.RLock()
+	defer nodeTypeLock.RUnlock()
+
+	return nodeTypeFactories[typ]
+}
+
+// CurrentLine 返回当前行。
+func (context *Context) CurrentLine() []byte {
+	return context.currentLine
+}
+
+// Offset 返回当前行解析到的位置。
+func (context *Context) Offset() int {
+	return context.offset
+}
+
+// NextNonspace 返回当前行下一个非空字符的位置。
+func (context *Context) NextNonspace() int {
+	return context.nextNonspace
+}
+
+// Indent 返回当前行下一个非空字符前的缩进空格数。
+func (context *Context) Indent() int {
+	return context.indent
+}
+
+// Indented 判断当前行是否是缩进行（缩进大于等于 4 个空格）。
+func (context *Context) Indented() bool {
+	return context.indented
+}
+
+// Blank 判断当前行余下的部分是否是空行。
+func (context *Context) Blank() bool {
+	return context.blank
+}
+
+// Tip 返回当前的末梢节点。
+func (context *Context) Tip() Node {
+	return context.tip
+}
+
+// AdvanceOffset 将当前行的解析位置向后移动 count 个字符，columns 为 true 时按列计算（制表符按 4 列对齐）。
+// 注意不能越过行尾的换行符。
+func (context *Context) AdvanceOffset(count int, columns bool) {
+	context.advanceOffset(count, columns)
+}
+
+// AdvanceNextNonspace 将当前行的解析位置移动到下一个非空字符处。
+func (context *Context) AdvanceNextNonspace() {
+	context.advanceNextNonspace()
+}
+
+// CloseUnmatchedBlocks 最终化所有未匹配的块节点，起始新的块节点前需要调用。
+func (context *Context) CloseUnmatchedBlocks() {
+	context.closeUnmatchedBlocks()
+}
+
+// AddChild 将 child 作为子节点添加到末梢节点上，如果末梢节点不能包含 child 则会向父节点方向查找。
+func (context *Context) AddChild(child Node) {
+	context.addChild(child)
+}
+
+// CloseBlock 在当前行关闭块节点 block，block 中所有未关闭的子孙节点也会一并关闭。
+// 一般用于在 Continue 中处理块的结束标记行，调用后 Continue 需要返回 2 结束该行的处理。
+func (context *Context) CloseBlock(block Node) {
+	for tip := context.tip; nil != tip; tip = context.tip {
+		if tip == block {
+			context.finalize(tip, context.lineNum)
+			return
+		}
+		context.finalize(tip, context.lineNum-1)
+	}
+}
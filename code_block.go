@@ -14,6 +14,8 @@ package lute
 
 import (
 	"bytes"
+	"strconv"
+	"strings"
 )
 
 // CodeBlock 描述了代码块节点结构。
@@ -32,7 +34,7 @@ func (codeBlock *CodeBlock) Continue(context *Context) int {
 	if codeBlock.isFenced {
 		if indent <= 3 && codeBlock.isFencedCodeClose(ln[context.nextNonspace:], codeBlock.fenceChar, codeBlock.fenceLength) {
 			// closing fence - we're at end of line, so we can return
-			context.finalize(codeBlock)
+			context.finalize(codeBlock, context.lineNum)
 			return 2
 		} else {
 			// skip optional spaces of fence offset
@@ -138,3 +140,107 @@ func (codeBlock *CodeBlock) isFencedCodeClose(tokens items, openMarker byte, num
 	}
 	return true
 }
+
+// codeBlockInfo 描述了从围栏代码块信息中解析出的语言和属性。
+type codeBlockInfo struct {
+	language        items    // 语言，没有指定语言时为 nil
+	title           items    // 标题，一般是文件名，没有指定标题时为 nil
+	highlightLines  [][2]int // 高亮行范围，行号是代码块中的第几行（从 1 开始，和起始行号无关），包括两端
+	lineNumbers     bool     // 是否显示行号
+	lineNumberStart int      // 起始行号
+}
+
+// parseCodeBlockInfo 解析围栏代码块信息 info，比如 go {3,5-7} linenos title="main.go"。第一个单词是语言，后面可以跟上如下属性：
+// {3,5-7} 表示高亮第 3 行和第 5 到 7 行；linenos 表示显示行号；linenostart=10 表示从 10 开始显示行号；title="main.go" 表示标题，
+// 标题包含空白时需要使用引号包裹。标题也可以使用 go:main.go 的形式直接跟在语言后面。无法识别的属性将被忽略。
+// {} 只有作为单独的单词并且内容是行号范围时才会被识别，所以 {r setup} 这样的信息会保持原样，语言为 {r。
+func parseCodeBlockInfo(info items) (ret *codeBlockInfo) {
+	ret = &codeBlockInfo{lineNumberStart: 1}
+	for i, word := range codeBlockInfoWords(fromItems(info)) {
+		if '{' == word[0] && '}' == word[len(word)-1] && isLineRanges(word[1:len(word)-1]) {
+			ret.highlightLines = parseLineRanges(word[1 : len(word)-1])
+		} else if "linenos" == word {
+			ret.lineNumbers = true
+		} else if strings.HasPrefix(word, "linenostart=") {
+			if start, err := strconv.Atoi(word[len("linenostart="):]); nil == err {
+				ret.lineNumbers = true
+				ret.lineNumberStart = start
+			}
+		} else if strings.HasPrefix(word, "title=") {
+			title := word[len("title="):]
+			if 2 <= len(title) && ('"' == title[0] || '\'' == title[0]) && title[0] == title[len(title)-1] {
+				title = title[1 : len(title)-1]
+			}
+			ret.title = items(title)
+		} else if 0 == i {
+			// 语言只能是信息的第一个单词
+			ret.language = items(word)
+			if colon := strings.IndexByte(word, ':'); 0 < colon && colon < len(word)-1 {
+				ret.language, ret.title = items(word[:colon]), items(word[colon+1:])
+			}
+		}
+	}
+	return
+}
+
+// codeBlockInfoWords 将围栏代码块信息 info 按空白拆分为单词。= 后面引号包裹的属性值（比如 title="a b"）以及 {} 包裹的行号范围
+// （比如 {1, 3-4}）中的空白不拆分。
+func codeBlockInfoWords(info string) (ret []string) {
+	isSpace := func(c byte) bool { return ' ' == c || '\t' == c }
+	for i := 0; i < len(info); {
+		if isSpace(info[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		if '{' == info[i] {
+			if end := strings.IndexByte(info[i:], '}'); 0 < end && isLineRanges(info[i+1:i+end]) && (i+end+1 == len(info) || isSpace(info[i+end+1])) {
+				i += end + 1
+				ret = append(ret, info[start:i])
+				continue
+			}
+		}
+		for ; i < len(info) && !isSpace(info[i]); i++ {
+			if c := info[i]; ('"' == c || '\'' == c) && start < i && '=' == info[i-1] {
+				if end := strings.IndexByte(info[i+1:], c); 0 <= end {
+					i += end + 1
+				}
+			}
+		}
+		ret = append(ret, info[start:i])
+	}
+	return
+}
+
+// isLineRanges 判断 ranges 是否是逗号分隔的行号范围，比如 3,5-7。
+func isLineRanges(ranges string) bool {
+	if "" == strings.TrimSpace(ranges) {
+		return false
+	}
+	for i := 0; i < len(ranges); i++ {
+		if c := ranges[i]; !isDigit(c) && ',' != c && '-' != c && ' ' != c && '\t' != c {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLineRanges 解析逗号分隔的行号范围 ranges，比如 3,5-7。
+func parseLineRanges(ranges string) (ret [][2]int) {
+	for _, r := range strings.Split(ranges, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(r), "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if nil != err {
+			continue
+		}
+		end := start
+		if 2 == len(bounds) {
+			if end, err = strconv.Atoi(strings.TrimSpace(bounds[1])); nil != err || end < start {
+				continue
+			}
+		}
+		ret = append(ret, [2]int{start, end})
+	}
+	return
+}
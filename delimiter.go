@@ -43,6 +43,7 @@ func (t *Tree) handleDelim(block Node, ctx *InlineContext) {
 
 	text := ctx.tokens[startPos:ctx.pos]
 	node := &Text{tokens: text}
+	ctx.setPos(node, startPos, ctx.pos)
 	block.AppendChild(block, node)
 
 	// 将这个分隔符入栈
@@ -74,6 +75,13 @@ func (t *Tree) processEmphasis(stackBottom *delimiter, ctx *InlineContext) {
 	openersBottom[itemUnderscore] = stackBottom
 	openersBottom[itemAsterisk] = stackBottom
 	openersBottom[itemTilde] = stackBottom
+	for token, exts := range t.context.option.inlineExts {
+		for _, ext := range exts {
+			if nil != ext.Delim {
+				openersBottom[token] = stackBottom
+			}
+		}
+	}
 
 	// find first closer above stack_bottom:
 	closer = ctx.delimiters
@@ -115,17 +123,14 @@ func (t *Tree) processEmphasis(stackBottom *delimiter, ctx *InlineContext) {
 			openerInl = opener.node
 			closerInl = closer.node
 
-			// remove used delimiters from stack elts and inlines
-			opener.num -= useDelims
-			closer.num -= useDelims
-
-			text := openerInl.Tokens()[0 : len(openerInl.Tokens())-useDelims]
-			openerInl.SetTokens(text)
-			text = closerInl.Tokens()[0 : len(closerInl.Tokens())-useDelims]
-			closerInl.SetTokens(text)
-
 			var emStrongDel Node
-			if 1 == useDelims {
+			if ext := t.inlineDelimExt(closercc); nil != ext {
+				if emStrongDel = ext.Delim(useDelims); nil == emStrongDel {
+					// 扩展不处理这次配对
+					closer = closer.next
+					continue
+				}
+			} else if 1 == useDelims {
 				emStrongDel = &Emphasis{&BaseNode{typ: NodeEmphasis}}
 			} else {
 				if itemTilde != closercc {
@@ -137,6 +142,16 @@ func (t *Tree) processEmphasis(stackBottom *delimiter, ctx *InlineContext) {
 				}
 			}
 
+			// remove used delimiters from stack elts and inlines
+			opener.num -= useDelims
+			closer.num -= useDelims
+
+			text := openerInl.Tokens()[0 : len(openerInl.Tokens())-useDelims]
+			openerInl.SetTokens(text)
+			text = closerInl.Tokens()[0 : len(closerInl.Tokens())-useDelims]
+			closerInl.SetTokens(text)
+			setDelimPos(openerInl, closerInl, emStrongDel, useDelims)
+
 			tmp := openerInl.Next()
 			for nil != tmp && tmp != closerInl {
 				next := tmp.Next()
@@ -15,4 +15,5 @@ package lute
 // Document 描述了根节点结构。
 type Document struct {
 	*BaseNode
+	LinkRefDefs []*Link // 链接引用定义，按照在文档中出现的顺序排列，重复定义的标签只保留第一个
 }
@@ -0,0 +1,110 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"strings"
+)
+
+// EmojiShortcode 描述了 Emoji 节点结构，由 :alias: 短码生成，tokens 为别名（不包括两边的 :）。
+type EmojiShortcode struct {
+	*BaseNode
+}
+
+// parseEmoji 解析 Emoji 短码 :alias:，只有别名是内置或者自定义的 Emoji 时才会解析成功，否则返回 nil。
+// 短码两边需要是单词边界（不能是字母、数字或者 :），避免把 1:100:1、std::a::b 这样的文本误识别为短码，紧挨着的短码除外，比如 :a::b:。
+func (t *Tree) parseEmoji(block Node, ctx *InlineContext) (ret Node) {
+	startPos := ctx.pos
+	if 0 < startPos && !isEmojiBoundary(ctx.tokens[startPos-1]) {
+		if _, ok := block.LastChild().(*EmojiShortcode); !ok || itemColon != ctx.tokens[startPos-1] {
+			return nil
+		}
+	}
+
+	end := emojiShortcodeEnd(ctx.tokens, startPos)
+	if 0 > end {
+		return nil
+	}
+	if next := end + 1; next < ctx.tokensLen && !isEmojiBoundary(ctx.tokens[next]) {
+		if itemColon != ctx.tokens[next] || 0 > emojiShortcodeEnd(ctx.tokens, next) {
+			return nil
+		}
+	}
+
+	alias := ctx.tokens[startPos+1 : end]
+	if _, ok := lookupEmoji(t.context.option.emojis, fromItems(alias)); !ok {
+		return nil
+	}
+
+	ctx.pos = end + 1
+	return &EmojiShortcode{&BaseNode{typ: NodeEmoji, tokens: alias}}
+}
+
+// emojiShortcodeEnd 返回 tokens 中从 start 处的 : 开始的 :alias: 形式短码的闭合 : 位置，不是短码形式时返回 -1。
+func emojiShortcodeEnd(tokens items, start int) int {
+	i := start + 1
+	for ; i < len(tokens) && isEmojiAliasChar(tokens[i]); i++ {
+	}
+	if i == start+1 || i == len(tokens) || itemColon != tokens[i] {
+		return -1
+	}
+	return i
+}
+
+// isEmojiBoundary 判断短码旁边的字符 token 是否是单词边界。
+func isEmojiBoundary(token byte) bool {
+	return !isASCIILetter(token) && !isDigit(token) && itemColon != token
+}
+
+// isEmojiAliasChar 判断 token 是否可以出现在 Emoji 别名中。
+func isEmojiAliasChar(token byte) bool {
+	return isASCIILetter(token) || isDigit(token) || itemUnderscore == token || itemPlus == token || itemHyphen == token
+}
+
+// lookupEmoji 查找别名 alias 对应的 Emoji，自定义 Emoji custom 优先于内置 Emoji。
+// 值为 :name: 形式时表示 alias 是另一个 Emoji 的别名，继续查找 name 对应的 Emoji。
+func lookupEmoji(custom map[string]string, alias string) (value string, ok bool) {
+	for i := 0; i < 8; i++ { // 限制别名跳转次数，避免别名相互引用导致死循环
+		if value, ok = custom[alias]; !ok {
+			if value, ok = emojis[alias]; !ok {
+				return
+			}
+		}
+
+		if 2 < len(value) && ':' == value[0] && ':' == value[len(value)-1] {
+			alias = value[1 : len(value)-1]
+			continue
+		}
+		return
+	}
+	return "", false
+}
+
+// isEmojiImage 判断 Emoji 值 value 是否是图片。值以常见图片后缀结尾时作为图片，否则作为 Unicode 字符。
+func isEmojiImage(value string) bool {
+	value = strings.ToLower(value)
+	for _, ext := range []string{".png", ".gif", ".jpg", ".jpeg", ".svg", ".webp"} {
+		if strings.HasSuffix(value, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// emojiImageSrc 返回图片 Emoji 的地址。图片值是绝对地址（包含 :// 或者以 / 开头）时直接使用，否则拼接在站点路径 site 后面。
+func emojiImageSrc(site, value string) string {
+	if "" == site || strings.Contains(value, "://") || strings.HasPrefix(value, "/") {
+		return value
+	}
+	return strings.TrimRight(site, "/") + "/" + value
+}
@@ -0,0 +1,152 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"strings"
+)
+
+// FootnotesDef 描述了脚注定义节点结构，脚注定义是一个块级容器，后续行需要缩进 4 个空格。
+type FootnotesDef struct {
+	*BaseNode
+	Label items // 脚注标签，不包括 [^ 和 ]
+}
+
+func (footnotesDef *FootnotesDef) Continue(context *Context) int {
+	if context.blank {
+		if nil == footnotesDef.firstChild {
+			// 内容为空的脚注定义后面的空行
+			return 1
+		}
+
+		context.advanceNextNonspace()
+	} else if context.indented {
+		context.advanceOffset(4, true)
+	} else {
+		return 1
+	}
+	return 0
+}
+
+// FootnotesRef 描述了脚注引用节点结构。
+type FootnotesRef struct {
+	*BaseNode
+	Label items // 脚注标签，不包括 [^ 和 ]
+}
+
+// parseFootnotesLabel 解析 tokens 开头的脚注标签 [^label]，返回标签 label 以及 [^label] 的长度 n，解析失败返回 nil。
+func parseFootnotesLabel(tokens items) (label items, n int) {
+	length := len(tokens)
+	if 3 > length || itemOpenBracket != tokens[0] || itemCaret != tokens[1] {
+		return nil, 0
+	}
+
+	i := 2
+	for ; i < length; i++ {
+		token := tokens[i]
+		if itemCloseBracket == token {
+			break
+		}
+		if isWhitespace(token) || itemOpenBracket == token || itemBackslash == token {
+			return nil, 0
+		}
+	}
+	if i == length || 2 == i {
+		return nil, 0
+	}
+	return tokens[2:i], i + 1
+}
+
+// parseFootnotesDef 判断当前行是否是脚注定义 [^label]: 开头，如果是的话将脚注定义添加到树上并记录到 context.footnotesDef 中。
+func (t *Tree) parseFootnotesDef() (ret *FootnotesDef) {
+	tokens := t.context.currentLine[t.context.nextNonspace:]
+	label, n := parseFootnotesLabel(tokens)
+	if nil == label || n >= len(tokens) || itemColon != tokens[n] {
+		return nil
+	}
+
+	t.context.advanceNextNonspace()
+	t.context.advanceOffset(n+1, false)
+	if token := t.context.currentLine.peek(t.context.offset); itemSpace == token || itemTab == token {
+		t.context.advanceOffset(1, true)
+	}
+
+	ret = &FootnotesDef{&BaseNode{typ: NodeFootnotesDef}, label}
+	lowerCaseLabel := strings.ToLower(fromItems(label))
+	if _, ok := t.context.footnotesDef[lowerCaseLabel]; !ok {
+		t.context.footnotesDef[lowerCaseLabel] = ret
+	}
+	return
+}
+
+// parseFootnotesRef 解析脚注引用 [^label]，只有存在相应的脚注定义时才会解析成功，否则返回 nil。
+func (t *Tree) parseFootnotesRef(ctx *InlineContext) (ret Node) {
+	label, n := parseFootnotesLabel(ctx.tokens[ctx.pos:])
+	if nil == label {
+		return nil
+	}
+	if _, ok := t.context.footnotesDef[strings.ToLower(fromItems(label))]; !ok {
+		return nil
+	}
+
+	ctx.pos += n
+	return &FootnotesRef{&BaseNode{typ: NodeFootnotesRef}, label}
+}
+
+// footnotes 描述了渲染脚注时使用的编号信息，脚注按照第一次被引用的顺序编号。
+type footnotes struct {
+	defs     []*FootnotesDef                 // 被引用过的脚注定义，按编号排序
+	nums     map[*FootnotesDef]int           // 脚注定义编号
+	refCount map[*FootnotesDef]int           // 脚注定义被引用的次数
+	refs     map[*FootnotesRef]int           // 脚注引用是相应脚注定义的第几次引用
+	refDefs  map[*FootnotesRef]*FootnotesDef // 脚注引用对应的脚注定义
+}
+
+// newFootnotes 遍历以 root 为根的树，收集脚注定义和引用并进行编号。
+func newFootnotes(root Node) (ret *footnotes) {
+	ret = &footnotes{nums: map[*FootnotesDef]int{}, refCount: map[*FootnotesDef]int{}, refs: map[*FootnotesRef]int{}, refDefs: map[*FootnotesRef]*FootnotesDef{}}
+
+	defs := map[string]*FootnotesDef{}
+	var refs []*FootnotesRef
+	Walk(root, func(n Node, entering bool) (WalkStatus, error) {
+		if !entering {
+			return WalkContinue, nil
+		}
+
+		switch node := n.(type) {
+		case *FootnotesDef:
+			label := strings.ToLower(fromItems(node.Label))
+			if _, ok := defs[label]; !ok {
+				defs[label] = node
+			}
+		case *FootnotesRef:
+			refs = append(refs, node)
+		}
+		return WalkContinue, nil
+	})
+
+	for _, ref := range refs {
+		def := defs[strings.ToLower(fromItems(ref.Label))]
+		if nil == def {
+			continue
+		}
+		if _, ok := ret.nums[def]; !ok {
+			ret.defs = append(ret.defs, def)
+			ret.nums[def] = len(ret.defs)
+		}
+		ret.refCount[def]++
+		ret.refs[ref] = ret.refCount[def]
+		ret.refDefs[ref] = def
+	}
+	return
+}
@@ -15,11 +15,12 @@ package lute
 import (
 	"bytes"
 	"strconv"
+	"strings"
 )
 
 // newFormatRenderer 创建一个格式化渲染器。
 func newFormatRenderer(option options) (ret *Renderer) {
-	ret = &Renderer{rendererFuncs: map[int]RendererFunc{}, option: option}
+	ret = &Renderer{rendererFuncs: map[int]RendererFunc{}, extRendererFuncs: option.formatRendererFuncs, option: option}
 
 	// 注册 CommonMark 渲染函数
 
@@ -47,110 +48,330 @@ func newFormatRenderer(option options) (ret *Renderer) {
 	ret.rendererFuncs[NodeStrikethrough] = ret.renderStrikethroughMarkdown
 	ret.rendererFuncs[NodeTaskListItemMarker] = ret.renderTaskListItemMarkerMarkdown
 	ret.rendererFuncs[NodeTable] = ret.renderTableMarkdown
-	ret.rendererFuncs[NodeTableHead] = ret.renderTableHeadMarkdown
-	ret.rendererFuncs[NodeTableRow] = ret.renderTableRowMarkdown
-	ret.rendererFuncs[NodeTableCell] = ret.renderTableCellMarkdown
+
+	// 注册脚注渲染函数
+
+	ret.rendererFuncs[NodeFootnotesDef] = ret.renderFootnotesDefMarkdown
+	ret.rendererFuncs[NodeFootnotesRef] = ret.renderFootnotesRefMarkdown
+
+	// 注册数学公式渲染函数
+
+	ret.rendererFuncs[NodeMathBlock] = ret.renderMathBlockMarkdown
+	ret.rendererFuncs[NodeInlineMath] = ret.renderInlineMathMarkdown
+
+	// 注册目录渲染函数
+
+	ret.rendererFuncs[NodeTOC] = ret.renderTOCMarkdown
+
+	// 注册元数据块渲染函数
+
+	ret.rendererFuncs[NodeFrontMatter] = ret.renderFrontMatterMarkdown
+
+	// 注册 Emoji 渲染函数
+
+	ret.rendererFuncs[NodeEmoji] = ret.renderEmojiMarkdown
+
+	// 注册提及和标签渲染函数
+
+	ret.rendererFuncs[NodeMention] = ret.renderMentionMarkdown
+	ret.rendererFuncs[NodeHashtag] = ret.renderMentionMarkdown
 
 	return
 }
 
-// TODO: 表的格式化应该按最宽的单元格对齐内容
+// newFormatSubRenderer 创建一个用于渲染子树的格式化渲染器，扩展渲染函数以及链接引用信息和当前渲染器保持一致。
+func (r *Renderer) newFormatSubRenderer() (ret *Renderer) {
+	ret = newFormatRenderer(r.option)
+	ret.extRendererFuncs = r.extRendererFuncs
+	ret.linkRefs = r.linkRefs
+	ret.subtreeRoot = r.subtreeRoot
+	return
+}
 
-func (r *Renderer) renderTableCellMarkdown(node Node, entering bool) (WalkStatus, error) {
+func (r *Renderer) renderMentionMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.writeByte('|')
+		r.Write(node.Tokens())
 	}
 	return WalkContinue, nil
 }
 
-func (r *Renderer) renderTableRowMarkdown(node Node, entering bool) (WalkStatus, error) {
-	if !entering {
-		r.writeString("|\n")
+func (r *Renderer) renderEmojiMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.writeByte(itemColon)
+		r.Write(node.Tokens())
+		r.writeByte(itemColon)
 	}
 	return WalkContinue, nil
 }
 
-func (r *Renderer) renderTableHeadMarkdown(node Node, entering bool) (WalkStatus, error) {
-	if !entering {
-		r.writeString("|\n")
-		n := node.(*TableHead)
-		table := n.Parent().(*Table)
-		for i := 0; i < len(table.Aligns); i++ {
-			align := table.Aligns[i]
-			switch align {
-			case 0:
-				r.writeString("|---")
-			case 1:
-				r.writeString("|:---")
-			case 2:
-				r.writeString("|:---:")
-			case 3:
-				r.writeString("|---:")
+func (r *Renderer) renderFrontMatterMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		// 元数据原样输出
+		delimiter := bytes.Repeat([]byte{node.(*FrontMatterBlock).delimiter}, 3)
+		r.Write(delimiter)
+		r.writeByte(itemNewline)
+		r.Write(node.Tokens())
+		r.Write(delimiter)
+		r.WriteString("\n\n")
+	}
+	return WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderTOCMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.Newline()
+		r.WriteString("[toc]\n\n")
+	}
+	return WalkContinue, nil
+}
+
+func (r *Renderer) renderInlineMathMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.writeByte(itemDollar)
+		r.Write(node.Tokens())
+		r.writeByte(itemDollar)
+	}
+	return WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderMathBlockMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		listPadding := 0
+		if grandparent := node.Parent().Parent(); nil != grandparent {
+			if list, ok := grandparent.(*List); ok && node != r.subtreeRoot { // List.ListItem.MathBlock
+				if node.Parent().FirstChild() != node {
+					listPadding = list.padding
+				}
+			}
+		}
+		padding := bytes.Repeat([]byte{itemSpace}, listPadding)
+
+		// 公式内容原样输出，在列表项中时每行需要加上列表项缩进
+		r.Newline()
+		r.Write(padding)
+		r.WriteString("$$\n")
+		if 0 < listPadding {
+			for _, line := range bytes.SplitAfter(node.Tokens(), []byte{itemNewline}) {
+				if 0 < len(line) && itemNewline != line[0] {
+					r.Write(padding)
+				}
+				r.Write(line)
 			}
+		} else {
+			r.Write(node.Tokens())
 		}
-		r.writeString("|\n")
+		r.Write(padding)
+		r.WriteString("$$\n\n")
+	}
+	return WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderFootnotesRefMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.WriteString("[^")
+		r.Write(node.(*FootnotesRef).Label)
+		r.writeByte(']')
 	}
 	return WalkContinue, nil
 }
 
+func (r *Renderer) renderFootnotesDefMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if !entering {
+		return WalkContinue, nil
+	}
+
+	// 使用一个新的渲染器渲染脚注定义的内容，然后将第一行之后的非空行缩进 4 个空格
+	renderer := r.newFormatSubRenderer()
+	for child := node.FirstChild(); nil != child; child = child.Next() {
+		if err := renderer.render(child); nil != err {
+			return WalkStop, err
+		}
+	}
+
+	r.Newline()
+	r.WriteString("[^")
+	r.Write(node.(*FootnotesDef).Label)
+	r.WriteString("]:")
+	lines := bytes.Split(bytes.TrimRight(renderer.writer.Bytes(), "\n"), []byte{itemNewline})
+	for i, line := range lines {
+		if 0 == i {
+			if 0 < len(line) {
+				r.writeByte(itemSpace)
+				r.Write(line)
+			}
+			continue
+		}
+
+		r.writeByte(itemNewline)
+		if 0 < len(line) {
+			r.WriteString("    ")
+			r.Write(line)
+		}
+	}
+	r.WriteString("\n\n")
+	return WalkSkipChildren, nil
+}
+
 func (r *Renderer) renderTableMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if !entering {
-		r.writeByte('\n')
+		return WalkContinue, nil
 	}
-	return WalkContinue, nil
+
+	// 先渲染所有单元格内容并计算每列的最大显示宽度，然后按列宽和对齐方式填充空格
+	table := node.(*Table)
+	var rows [][]items
+	widths := make([]int, len(table.Aligns))
+	for row := node.FirstChild(); nil != row; row = row.Next() {
+		var cells []items
+		for i, cell := 0, row.FirstChild(); nil != cell && i < len(widths); i, cell = i+1, cell.Next() {
+			content, err := r.renderTableCellContent(cell)
+			if nil != err {
+				return WalkStop, err
+			}
+			cells = append(cells, content)
+			if width := displayWidth(content); width > widths[i] {
+				widths[i] = width
+			}
+		}
+		rows = append(rows, cells)
+	}
+	for i := range widths {
+		if 3 > widths[i] { // 分隔行每列至少需要 3 个字符，比如 :-:
+			widths[i] = 3
+		}
+	}
+
+	for i, cells := range rows {
+		for j, cell := range cells {
+			r.WriteString("| ")
+			padding := widths[j] - displayWidth(cell)
+			switch table.Aligns[j] {
+			case 2:
+				r.WriteString(strings.Repeat(" ", padding/2))
+				r.Write(cell)
+				r.WriteString(strings.Repeat(" ", padding-padding/2))
+			case 3:
+				r.WriteString(strings.Repeat(" ", padding))
+				r.Write(cell)
+			default:
+				r.Write(cell)
+				r.WriteString(strings.Repeat(" ", padding))
+			}
+			r.writeByte(itemSpace)
+		}
+		r.WriteString("|\n")
+
+		if 0 == i { // 表头后面是分隔行
+			for j, align := range table.Aligns {
+				r.WriteString("| ")
+				switch align {
+				case 0:
+					r.WriteString(strings.Repeat("-", widths[j]))
+				case 1:
+					r.WriteString(":" + strings.Repeat("-", widths[j]-1))
+				case 2:
+					r.WriteString(":" + strings.Repeat("-", widths[j]-2) + ":")
+				case 3:
+					r.WriteString(strings.Repeat("-", widths[j]-1) + ":")
+				}
+				r.writeByte(itemSpace)
+			}
+			r.WriteString("|\n")
+		}
+	}
+	r.writeByte(itemNewline)
+	return WalkSkipChildren, nil
+}
+
+// renderTableCellContent 使用一个新的渲染器渲染单元格 cell 的内容，内容中的 | 需要转义，否则会被当作单元格分隔符。
+func (r *Renderer) renderTableCellContent(cell Node) (ret items, err error) {
+	renderer := r.newFormatSubRenderer()
+	for child := cell.FirstChild(); nil != child; child = child.Next() {
+		if err = renderer.render(child); nil != err {
+			return
+		}
+	}
+	ret = bytes.TrimSpace(renderer.writer.Bytes())
+	return bytes.Replace(ret, []byte("|"), []byte("\\|"), -1), nil
 }
 
 func (r *Renderer) renderStrikethroughMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.writeString("~~")
+		r.WriteString("~~")
 	} else {
-		r.writeString("~~")
+		r.WriteString("~~")
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderImageMarkdown(node Node, entering bool) (WalkStatus, error) {
-	n := node.(*Image)
 	if entering {
-		r.writeString("![")
-		r.write(n.firstChild.Tokens())
-		r.writeString("](")
-		r.write(n.Destination)
-		if nil != n.Title {
-			r.writeString(" \"")
-			r.write(n.Title)
-			r.writeByte('"')
-		}
-		r.writeByte(')')
+		r.WriteString("![")
+	} else {
+		n := node.(*Image)
+		r.writeByte(itemCloseBracket)
+		r.writeLinkTail(n.Destination, n.Title, n.RefLabel, n.refType, "")
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderLinkMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
+		r.writeByte(itemOpenBracket)
+	} else {
 		n := node.(*Link)
-		r.writeString("[")
-		r.write(n.firstChild.Tokens()) // FIXME: 未解决链接嵌套，另外还需要考虑链接引用定义
-		r.writeString("](")
-		r.write(n.Destination)
-		if nil != n.Title {
-			r.writeString(" \"")
-			r.write(n.Title)
-			r.writeByte('"')
+		r.writeByte(itemCloseBracket)
+		num := ""
+		if nil != r.linkRefs {
+			num = r.linkRefs.nums[n]
 		}
-		r.writeByte(')')
+		r.writeLinkTail(n.Destination, n.Title, n.RefLabel, n.refType, num)
 	}
 	return WalkContinue, nil
 }
 
+// writeLinkTail 输出链接或图片文本后面的部分。引用链接在标签已经定义并且地址和标题都和定义相同时保持原来的引用形式，
+// num 不为空时输出编号引用 [num]，其他情况（比如修改了引用链接的地址）输出内联形式 (destination "title")。
+func (r *Renderer) writeLinkTail(destination, title, refLabel items, refType int, num string) {
+	if 0 != refType && nil != r.linkRefs && r.linkRefs.matchDef(refLabel, destination, title) {
+		switch refType {
+		case linkRefFull:
+			r.writeByte(itemOpenBracket)
+			r.Write(refLabel)
+			r.writeByte(itemCloseBracket)
+		case linkRefCollapsed:
+			r.WriteString("[]")
+		}
+		return
+	}
+	if "" != num {
+		r.WriteString("[" + num + "]")
+		return
+	}
+
+	r.writeByte(itemOpenParen)
+	r.Write(destination)
+	r.writeLinkTitle(title)
+	r.writeByte(itemCloseParen)
+}
+
+// writeLinkTitle 输出链接标题 title，标题中的 " 需要转义。
+func (r *Renderer) writeLinkTitle(title items) {
+	if nil != title {
+		r.WriteString(" \"")
+		r.Write(bytes.Replace(title, []byte("\""), []byte("\\\""), -1))
+		r.writeByte('"')
+	}
+}
+
 func (r *Renderer) renderHTMLMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if !entering {
 		return WalkContinue, nil
 	}
 
-	r.newline()
-	r.write(node.Tokens())
-	r.newline()
+	r.Newline()
+	r.Write(node.Tokens())
+	r.Newline()
 	return WalkContinue, nil
 }
 
@@ -159,11 +380,37 @@ func (r *Renderer) renderInlineHTMLMarkdown(node Node, entering bool) (WalkStatu
 		return WalkContinue, nil
 	}
 
-	r.write(node.Tokens())
+	r.Write(node.Tokens())
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderDocumentMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.linkRefs = newLinkRefs(node.(*Document), r.option.FormatLinkRefDefsSorted, r.option.FormatInlineLinksToRefs)
+		return WalkContinue, nil
+	}
+
+	// 链接引用定义统一输出到文档末尾，和前面的块之间需要空行，否则可能被并入 HTML 块等前面的块
+	if 0 < len(r.linkRefs.defs) && 0 < r.writer.Len() {
+		for !bytes.HasSuffix(r.writer.Bytes(), []byte("\n\n")) {
+			r.writeByte(itemNewline)
+		}
+	}
+	for _, def := range r.linkRefs.defs {
+		r.writeByte(itemOpenBracket)
+		r.Write(def.RefLabel)
+		r.WriteString("]: ")
+		if 0 < len(def.Destination) {
+			r.Write(def.Destination)
+		} else {
+			r.WriteString("<>")
+		}
+		r.writeLinkTitle(def.Title)
+		r.writeByte(itemNewline)
+	}
+	if 0 < len(r.linkRefs.defs) {
+		r.writeByte(itemNewline)
+	}
 	return WalkContinue, nil
 }
 
@@ -173,7 +420,7 @@ func (r *Renderer) renderParagraphMarkdown(node Node, entering bool) (WalkStatus
 	inTightList := false
 	lastListItemLastPara := false
 	if parent := node.Parent(); nil != parent {
-		if listItem, ok := parent.(*ListItem); ok { // ListItem.Paragraph
+		if listItem, ok := parent.(*ListItem); ok && node != r.subtreeRoot { // ListItem.Paragraph
 			inList = true
 
 			// 必须通过列表（而非列表项）上的紧凑标识判断，因为在设置该标识时仅设置了 List.tight
@@ -200,9 +447,15 @@ func (r *Renderer) renderParagraphMarkdown(node Node, entering bool) (WalkStatus
 	}
 
 	if entering {
-		r.write(bytes.Repeat([]byte{itemSpace}, listPadding))
+		r.Write(bytes.Repeat([]byte{itemSpace}, listPadding))
+		if ProseWrapPreserve != r.option.FormatProseWrap {
+			if err := r.renderProse(node); nil != err {
+				return WalkStop, err
+			}
+			return WalkSkipChildren, nil
+		}
 	} else {
-		r.newline()
+		r.Newline()
 		if !inList {
 			r.writeByte('\n')
 		} else {
@@ -219,16 +472,19 @@ func (r *Renderer) renderTextMarkdown(node Node, entering bool) (WalkStatus, err
 		return WalkContinue, nil
 	}
 
-	if typ := node.Parent().Type(); NodeLink != typ && NodeImage != typ {
-		r.write(node.Tokens())
+	tokens := node.Tokens()
+	if r.option.Math {
+		// 文本中的 $ 是转义过的或者没有构成公式的，需要转义输出，否则再次解析时可能会被识别为公式
+		tokens = bytes.Replace(tokens, items("$"), items("\\$"), -1)
 	}
+	r.Write(tokens)
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderCodeSpanMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
 		r.writeByte('`')
-		r.write(node.Tokens())
+		r.Write(node.Tokens())
 		return WalkSkipChildren, nil
 	}
 
@@ -238,82 +494,230 @@ func (r *Renderer) renderCodeSpanMarkdown(node Node, entering bool) (WalkStatus,
 
 func (r *Renderer) renderCodeBlockMarkdown(node Node, entering bool) (WalkStatus, error) {
 	n := node.(*CodeBlock)
-	if !n.isFenced {
-		n.fenceLength = 3
-	}
+	fence := r.codeBlockFence(n)
 	if entering {
 		listPadding := 0
 		if grandparent := node.Parent().Parent(); nil != grandparent {
-			if list, ok := grandparent.(*List); ok { // List.ListItem.CodeBlock
+			if list, ok := grandparent.(*List); ok && node != r.subtreeRoot { // List.ListItem.CodeBlock
 				if node.Parent().FirstChild() != node {
 					listPadding = list.padding
 				}
 			}
 		}
 
-		r.newline()
+		r.Newline()
 		if 0 < listPadding {
-			r.write(bytes.Repeat([]byte{itemSpace}, listPadding))
+			r.Write(bytes.Repeat([]byte{itemSpace}, listPadding))
 		}
-		r.write(bytes.Repeat([]byte{itemBacktick}, n.fenceLength))
-		r.write(n.info)
+		r.Write(fence)
+		r.Write(n.info)
 		r.writeByte('\n')
 		if 0 < listPadding {
 			lines := bytes.Split(n.tokens, []byte{itemNewline})
 			length := len(lines)
 			for i, line := range lines {
-				r.write(bytes.Repeat([]byte{itemSpace}, listPadding))
-				r.write(line)
+				r.Write(bytes.Repeat([]byte{itemSpace}, listPadding))
+				r.Write(line)
 				if i < length-1 {
 					r.writeByte('\n')
 				}
 			}
 		} else {
-			r.write(n.tokens)
+			r.Write(n.tokens)
 		}
 		return WalkSkipChildren, nil
 	}
 
-	r.write(bytes.Repeat([]byte{itemBacktick}, n.fenceLength))
-	r.writeString("\n\n")
+	r.Write(fence)
+	r.WriteString("\n\n")
 	return WalkContinue, nil
 }
 
-func (r *Renderer) renderEmphasisMarkdown(node Node, entering bool) (WalkStatus, error) {
-	if entering {
-		r.writeByte('*')
-	} else {
-		r.writeByte('*')
+// codeBlockFence 根据格式化选项返回代码块 n 使用的围栏。围栏需要比内容中最长的同字符围栏更长，否则内容会提前闭合代码块。
+func (r *Renderer) codeBlockFence(n *CodeBlock) items {
+	char := r.option.FormatFenceChar
+	if itemTilde != char {
+		char = itemBacktick
+	}
+	if itemBacktick == char && 0 <= bytes.IndexByte(n.info, itemBacktick) {
+		char = itemTilde // ` 围栏的信息字符串中不能包含 `
+	}
+
+	length := r.option.FormatFenceLength
+	if 3 > length {
+		length = 3
+		if n.isFenced && length < n.fenceLength {
+			length = n.fenceLength
+		}
 	}
+	for _, line := range bytes.Split(n.tokens, []byte{itemNewline}) {
+		line = bytes.TrimLeft(line, " ")
+		run := 0
+		for run < len(line) && char == line[run] {
+			run++
+		}
+		if run >= length {
+			length = run + 1
+		}
+	}
+	return bytes.Repeat([]byte{char}, length)
+}
+
+func (r *Renderer) renderEmphasisMarkdown(node Node, entering bool) (WalkStatus, error) {
+	r.writeByte(r.emphasisMarker(node))
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderStrongMarkdown(node Node, entering bool) (WalkStatus, error) {
-	if entering {
-		r.writeString("**")
-	} else {
-		r.writeString("**")
-	}
+	marker := r.emphasisMarker(node)
+	r.writeByte(marker)
+	r.writeByte(marker)
 	return WalkContinue, nil
 }
 
-func (r *Renderer) renderBlockquoteMarkdown(n Node, entering bool) (WalkStatus, error) {
-	if entering {
-		r.newline()
-		r.writeString("> ") // 带个空格更好一些
-	} else {
-		r.newline()
+// emphasisMarker 返回强调或加粗节点 node 使用的标识符。_ 在单词内部不能构成强调，和相邻的 _ 连在一起时会改变定界符长度，
+// 所以标识符内外紧挨着字母、数字、_ 或者使用 _ 的相邻、嵌套强调时使用 *。
+func (r *Renderer) emphasisMarker(node Node) byte {
+	marker := r.option.FormatEmphasisMarker
+	if NodeStrong == node.Type() {
+		marker = r.option.FormatStrongMarker
 	}
-	return WalkContinue, nil
+	if itemUnderscore != marker {
+		return itemAsterisk
+	}
+
+	if previous := node.Previous(); nil != previous {
+		if isEmphasis(previous) && itemUnderscore == r.emphasisMarker(previous) {
+			return itemAsterisk
+		}
+		if tokens := previous.Tokens(); NodeText == previous.Type() && 0 < len(tokens) && isUnderscoreBreaker(tokens[len(tokens)-1]) {
+			return itemAsterisk
+		}
+	}
+	if next := node.Next(); nil != next {
+		if tokens := next.Tokens(); NodeText == next.Type() && 0 < len(tokens) && isUnderscoreBreaker(tokens[0]) {
+			return itemAsterisk
+		}
+	}
+	if first := node.FirstChild(); nil != first && ((isEmphasis(first) && itemUnderscore == r.emphasisMarker(first)) || (NodeText == first.Type() && bytes.HasPrefix(first.Tokens(), []byte{itemUnderscore}))) {
+		return itemAsterisk
+	}
+	if last := node.LastChild(); nil != last && ((isEmphasis(last) && itemUnderscore == r.emphasisMarker(last)) || (NodeText == last.Type() && bytes.HasSuffix(last.Tokens(), []byte{itemUnderscore}))) {
+		return itemAsterisk
+	}
+	return itemUnderscore
+}
+
+// isEmphasis 判断 node 是否是强调或者加粗节点。
+func isEmphasis(node Node) bool {
+	return NodeEmphasis == node.Type() || NodeStrong == node.Type()
+}
+
+// isUnderscoreBreaker 判断紧挨着 _ 标识符外侧的 token 是否会使 _ 不能构成强调：单词字符（非 ASCII 字节，比如汉字的 UTF-8
+// 编码，也算作单词字符）或者 _。
+func isUnderscoreBreaker(token byte) bool {
+	return isASCIILetterNum(token) || 0x80 <= token || itemUnderscore == token
+}
+
+func (r *Renderer) renderBlockquoteMarkdown(node Node, entering bool) (WalkStatus, error) {
+	if !entering {
+		return WalkContinue, nil
+	}
+
+	// 使用一个新的渲染器渲染块引用的内容，然后为每一行加上 >，第一行之后还需要加上外层列表项的缩进
+	renderer := r.newFormatSubRenderer()
+	for child := node.FirstChild(); nil != child; child = child.Next() {
+		if err := renderer.render(child); nil != err {
+			return WalkStop, err
+		}
+	}
+
+	prefix, _ := r.linePrefix(node)
+	if listItem, ok := node.Parent().(*ListItem); !ok || listItem.FirstChild() != node {
+		// 列表项的第一个子节点紧跟在列表项标识符后面，其他情况需要另起一行
+		r.Newline()
+		r.Write(prefix)
+	}
+	lines := bytes.Split(bytes.TrimRight(renderer.writer.Bytes(), "\n"), []byte{itemNewline})
+	for i, line := range lines {
+		if 0 < i {
+			r.writeByte(itemNewline)
+			r.Write(prefix)
+		}
+		if 0 < len(line) {
+			r.WriteString("> ") // 带个空格更好一些
+			r.Write(line)
+		} else {
+			r.writeByte(itemGreater)
+		}
+	}
+	r.WriteString("\n\n")
+	return WalkSkipChildren, nil
+}
+
+// linePrefix 返回节点 node 的内容换行后需要加上的前缀，也就是外层列表项的缩进。块引用和脚注定义会统一为内容的每一行加上前缀，
+// 所以遇到它们时停止，它们占用的列数通过 indent 返回。子树渲染时根节点外层的前缀由调用方处理。
+func (r *Renderer) linePrefix(node Node) (prefix items, indent int) {
+	stopped := false
+	for child, n := node, node.Parent(); nil != n && child != r.subtreeRoot; child, n = n, n.Parent() {
+		switch container := n.(type) {
+		case *ListItem:
+			width := len(r.listItemMarker(container)) + 1
+			if stopped {
+				indent += width
+			} else {
+				prefix = append(bytes.Repeat([]byte{itemSpace}, width), prefix...)
+			}
+		case *Blockquote:
+			stopped = true
+			indent += 2
+		case *FootnotesDef:
+			stopped = true
+			indent += 4
+		}
+	}
+	return
 }
 
 func (r *Renderer) renderHeadingMarkdown(node Node, entering bool) (WalkStatus, error) {
 	n := node.(*Heading)
+	setext := r.option.FormatSetextHeading && 2 >= n.Level && nil != n.FirstChild() // Setext 标题只有一级和二级，并且内容不能为空
+	prefix, _ := r.linePrefix(node)
 	if entering {
-		r.write(bytes.Repeat([]byte{itemCrosshatch}, n.Level)) // 统一使用 ATX 标题，不使用 Setext 标题
-		r.writeByte(itemSpace)
+		if buf := r.writer.Bytes(); 0 == len(buf) || itemNewline == buf[len(buf)-1] {
+			// 不是列表项的第一个子节点时标题另起一行，需要加上列表项的缩进
+			r.Write(prefix)
+		}
+		if !setext {
+			r.Write(bytes.Repeat([]byte{itemCrosshatch}, n.Level))
+			r.writeByte(itemSpace)
+		}
 	} else {
-		r.newline()
+		if nil != n.CustomID {
+			if nil != n.FirstChild() {
+				r.writeByte(itemSpace)
+			}
+			r.WriteString("{#")
+			r.Write(n.CustomID)
+			r.writeByte('}')
+		}
+		if setext {
+			// 下划线和标题最后一行等宽
+			// 列表项中的标题行以列表项标识符或者缩进开头，下划线也需要相同的缩进，宽度不计入缩进
+			buf := r.writer.Bytes()
+			width := displayWidth(buf[bytes.LastIndexByte(buf, itemNewline)+1:]) - len(prefix)
+			if 3 > width {
+				width = 3
+			}
+			marker := "="
+			if 2 == n.Level {
+				marker = "-"
+			}
+			r.Newline()
+			r.Write(prefix)
+			r.WriteString(strings.Repeat(marker, width))
+		}
+		r.Newline()
 		r.writeByte(itemNewline)
 	}
 	return WalkContinue, nil
@@ -325,7 +729,7 @@ func (r *Renderer) renderListMarkdown(node Node, entering bool) (WalkStatus, err
 	} else {
 		n := node.(*List)
 		if n.tight {
-			r.newline()
+			r.Newline()
 		}
 		r.listLevel--
 	}
@@ -335,24 +739,47 @@ func (r *Renderer) renderListMarkdown(node Node, entering bool) (WalkStatus, err
 func (r *Renderer) renderListItemMarkdown(node Node, entering bool) (WalkStatus, error) {
 	n := node.(*ListItem)
 	if entering {
-		r.newline()
+		r.Newline()
 		if 1 < r.listLevel {
 			parent := n.Parent().Parent().(*ListItem)
-			r.write(bytes.Repeat([]byte{itemSpace}, len(parent.marker)+1))
-			if 1 == parent.listData.typ {
-				r.writeByte(' ') // 有序列表需要加上分隔符 . 或者 ) 的一个字符长度
-			}
-		}
-		if 1 == n.listData.typ {
-			r.writeString(strconv.Itoa(n.num) + ".")
-		} else {
-			r.write(n.marker)
+			r.Write(bytes.Repeat([]byte{itemSpace}, len(r.listItemMarker(parent))+1))
 		}
+		r.Write(r.listItemMarker(n))
 		r.writeByte(' ')
 	}
 	return WalkContinue, nil
 }
 
+// listItemMarker 根据格式化选项返回列表项 n 的标识符，有序列表的标识符包括分隔符 .。
+func (r *Renderer) listItemMarker(n *ListItem) items {
+	if 1 == n.listData.typ {
+		num := n.num
+		if r.option.FormatOrderedListAllOne {
+			num = n.Parent().(*List).start
+		}
+		return items(strconv.Itoa(num) + ".")
+	}
+	return items{r.bulletMarker(n.Parent().(*List))}
+}
+
+// bulletMarker 返回无序列表 list 使用的标识符。紧挨着的两个无序列表使用同样的标识符会被合并，所以这时需要换用其他标识符。
+func (r *Renderer) bulletMarker(list *List) byte {
+	marker := r.option.FormatBulletMarker
+	if itemHyphen != marker && itemAsterisk != marker && itemPlus != marker {
+		return list.bulletChar[0]
+	}
+
+	if previous, ok := list.Previous().(*List); ok && 1 != previous.listData.typ {
+		if r.bulletMarker(previous) == marker {
+			if itemHyphen == marker {
+				return itemAsterisk
+			}
+			return itemHyphen
+		}
+	}
+	return marker
+}
+
 func (r *Renderer) renderTaskListItemMarkerMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
 		n := node.(*TaskListItemMarker)
@@ -369,8 +796,12 @@ func (r *Renderer) renderTaskListItemMarkerMarkdown(node Node, entering bool) (W
 
 func (r *Renderer) renderThematicBreakMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.newline()
-		r.writeString("---\n\n")
+		r.Newline()
+		thematicBreak := r.option.FormatThematicBreak
+		if !isThematicBreak(thematicBreak) {
+			thematicBreak = "---"
+		}
+		r.WriteString(thematicBreak + "\n\n")
 	}
 	return WalkContinue, nil
 }
@@ -378,9 +809,9 @@ func (r *Renderer) renderThematicBreakMarkdown(node Node, entering bool) (WalkSt
 func (r *Renderer) renderHardBreakMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
 		if !r.option.SoftBreak2HardBreak {
-			r.writeString("\\\n")
+			r.WriteString("\\\n")
 		} else {
-			r.writeString("\n")
+			r.WriteString("\n")
 		}
 	}
 	return WalkContinue, nil
@@ -388,7 +819,24 @@ func (r *Renderer) renderHardBreakMarkdown(node Node, entering bool) (WalkStatus
 
 func (r *Renderer) renderSoftBreakMarkdown(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.newline()
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
+
+// isThematicBreak 判断 s 是否是由至少 3 个相同的 -、* 或者 _ 以及空格组成的分隔线。
+func isThematicBreak(s string) bool {
+	markers := 0
+	var marker rune
+	for _, c := range s {
+		if ' ' == c {
+			continue
+		}
+		if ('-' != c && '*' != c && '_' != c) || (0 != marker && marker != c) {
+			return false
+		}
+		marker = c
+		markers++
+	}
+	return 3 <= markers
+}
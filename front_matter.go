@@ -0,0 +1,131 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+	"errors"
+)
+
+// 元数据格式。
+const (
+	FrontMatterYAML = "yaml" // --- 包裹的 YAML
+	FrontMatterTOML = "toml" // +++ 包裹的 TOML
+)
+
+// FrontMatterDecodeFunc 描述了元数据解码函数签名，format 为 FrontMatterYAML 或者 FrontMatterTOML，data 为元数据块内容。
+// Lute 内置的解码函数支持 JSON 以及 YAML、TOML 的常用子集，需要完整支持 YAML、TOML 规范时由调用方通过 FrontMatterDecoder
+// 设置解码函数。
+type FrontMatterDecodeFunc func(format string, data []byte) (map[string]interface{}, error)
+
+// FrontMatterBlock 描述了文档开头的元数据块节点结构，--- 包裹的内容为 YAML，+++ 包裹的内容为 TOML。
+type FrontMatterBlock struct {
+	*BaseNode
+	delimiter byte // 分隔符，- 或者 +
+}
+
+func (frontMatter *FrontMatterBlock) Continue(context *Context) int {
+	if isFrontMatterDelimiter(context.currentLine, frontMatter.delimiter) {
+		// 闭合分隔符行，该行已经处理完毕
+		context.finalize(frontMatter, context.lineNum)
+		return 2
+	}
+	return 0
+}
+
+func (frontMatter *FrontMatterBlock) Finalize(context *Context) {
+	// 第一行是开始分隔符行的剩余部分（空白），从第二行开始才是元数据内容
+	if i := bytes.IndexByte(frontMatter.tokens, itemNewline); 0 <= i {
+		frontMatter.tokens = frontMatter.tokens[i+1:]
+	} else {
+		frontMatter.tokens = nil
+	}
+}
+
+func (frontMatter *FrontMatterBlock) AcceptLines() bool {
+	return true
+}
+
+func (frontMatter *FrontMatterBlock) CanContain(nodeType int) bool {
+	return false
+}
+
+// isFrontMatterDelimiter 判断 line 是否是由 3 个 delimiter 组成的分隔符行。
+func isFrontMatterDelimiter(line items, delimiter byte) bool {
+	line = bytes.TrimRight(line, " \t\r\n")
+	return 3 == len(line) && delimiter == line[0] && delimiter == line[1] && delimiter == line[2]
+}
+
+// parseFrontMatter 判断文档第一行是否是元数据块的开始分隔符行，并且后续存在闭合分隔符行。
+func (t *Tree) parseFrontMatter() (ret *FrontMatterBlock) {
+	if 1 != t.context.lineNum || 0 != t.context.nextNonspace {
+		return nil
+	}
+
+	delimiter := t.context.currentLine[0]
+	if (itemHyphen != delimiter && itemPlus != delimiter) || !isFrontMatterDelimiter(t.context.currentLine, delimiter) {
+		return nil
+	}
+
+	// 没有闭合分隔符行的话不能作为元数据块，否则会吞掉整个文档
+	closed := false
+	for remains := t.lexer.input[t.lexer.offset:]; 0 < len(remains); {
+		i := bytes.IndexByte(remains, itemNewline)
+		if 0 > i {
+			i = len(remains) - 1
+		}
+		if isFrontMatterDelimiter(remains[:i+1], delimiter) {
+			closed = true
+			break
+		}
+		remains = remains[i+1:]
+	}
+	if !closed {
+		return nil
+	}
+
+	return &FrontMatterBlock{&BaseNode{typ: NodeFrontMatter, tokens: make(items, 0, 256)}, delimiter}
+}
+
+// FrontMatterRaw 返回文档开头的元数据块的格式 format 以及内容 data，文档没有元数据块时 format 为 ""，data 为 nil。
+func (t *Tree) FrontMatterRaw() (format string, data []byte) {
+	frontMatter, ok := t.Root.FirstChild().(*FrontMatterBlock)
+	if !ok {
+		return "", nil
+	}
+
+	if itemPlus == frontMatter.delimiter {
+		return FrontMatterTOML, frontMatter.tokens
+	}
+	return FrontMatterYAML, frontMatter.tokens
+}
+
+// FrontMatter 解码文档开头的元数据，文档没有元数据块时返回 nil，元数据不合法时返回错误。
+//
+// 通过 FrontMatterDecoder 设置了解码函数时使用该函数解码，否则使用内置的解码函数：内容以 { 开头时按 JSON 解码，否则解码
+// YAML 或者 TOML 的常用子集，不支持 YAML 的锚点、别名和标签，TOML 的日期时间按字符串返回。
+func (t *Tree) FrontMatter() (ret map[string]interface{}, err error) {
+	format, data := t.FrontMatterRaw()
+	if "" == format {
+		return nil, nil
+	}
+
+	decoder := t.context.option.frontMatterDecoder
+	if nil == decoder {
+		decoder = decodeFrontMatter
+	}
+	if ret, err = decoder(format, data); nil != err {
+		return nil, errors.New("decode " + format + " front matter failed: " + err.Error())
+	}
+	return
+}
@@ -0,0 +1,793 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// decodeFrontMatter 是内置的元数据解码函数，没有通过 FrontMatterDecoder 设置解码函数时使用。
+//
+// 内容以 { 开头时按 JSON 解码，否则按 format 解码 YAML 或者 TOML 的常用子集：
+//   - YAML：块映射、块序列、流式 [] 和 {}、单双引号字符串、| 和 > 块标量、整数、浮点数、布尔值和 null，不支持锚点、别名和标签
+//   - TOML：键值对、点分键、[table]、[[array]]、数组、内联表、单行和多行字符串、整数、浮点数和布尔值，日期时间按字符串返回
+func decodeFrontMatter(format string, data []byte) (ret map[string]interface{}, err error) {
+	content := strings.TrimSpace(string(data))
+	if strings.HasPrefix(content, "{") {
+		ret = map[string]interface{}{}
+		err = json.Unmarshal([]byte(content), &ret)
+		return
+	}
+
+	if FrontMatterTOML == format {
+		return decodeTOML(string(data))
+	}
+	return decodeYAML(string(data))
+}
+
+// yamlLine 描述了 YAML 中的一行。
+type yamlLine struct {
+	num    int    // 行号，从 1 开始
+	indent int    // 缩进空格数
+	text   string // 去掉缩进后的内容，空行和注释行为 ""
+	raw    string // 原始内容，用于块标量
+}
+
+// yamlDecoder 描述了 YAML 子集解码器。
+type yamlDecoder struct {
+	lines []*yamlLine
+	pos   int
+}
+
+func decodeYAML(data string) (ret map[string]interface{}, err error) {
+	d := &yamlDecoder{}
+	for i, raw := range strings.Split(data, "\n") {
+		raw = strings.TrimRight(raw, " \t\r")
+		text := strings.TrimLeft(raw, " ")
+		line := &yamlLine{num: i + 1, indent: len(raw) - len(text), raw: raw}
+		if "" != text && '\t' == text[0] {
+			return nil, yamlError(line, "found a tab character used as indentation")
+		}
+		if "" != text && '#' != text[0] {
+			line.text = text
+		}
+		d.lines = append(d.lines, line)
+	}
+
+	ret = map[string]interface{}{}
+	first := d.peek()
+	if nil == first {
+		return
+	}
+
+	value, err := d.block(first.indent)
+	if nil != err {
+		return nil, err
+	}
+	if line := d.peek(); nil != line {
+		return nil, yamlError(line, "unexpected indentation")
+	}
+	ret, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, yamlError(first, "front matter must be a mapping")
+	}
+	return
+}
+
+// peek 跳过空行和注释行，返回下一个有内容的行，没有时返回 nil。
+func (d *yamlDecoder) peek() *yamlLine {
+	for ; d.pos < len(d.lines); d.pos++ {
+		if "" != d.lines[d.pos].text {
+			return d.lines[d.pos]
+		}
+	}
+	return nil
+}
+
+// block 解析缩进为 indent 的映射或者序列。
+func (d *yamlDecoder) block(indent int) (interface{}, error) {
+	if isYAMLSeqItem(d.peek().text) {
+		return d.sequence(indent)
+	}
+	return d.mapping(indent)
+}
+
+func (d *yamlDecoder) mapping(indent int) (interface{}, error) {
+	ret := map[string]interface{}{}
+	for line := d.peek(); nil != line && indent == line.indent; line = d.peek() {
+		if isYAMLSeqItem(line.text) {
+			return nil, yamlError(line, "unexpected sequence item")
+		}
+		key, value, ok := splitYAMLKeyValue(line.text)
+		if !ok {
+			return nil, yamlError(line, "could not find expected ':'")
+		}
+		k, err := parseYAMLScalar(line, key)
+		if nil != err {
+			return nil, err
+		}
+		d.pos++
+		v, err := d.value(line, indent, value, true)
+		if nil != err {
+			return nil, err
+		}
+		ret[yamlKeyString(k)] = v
+	}
+	return ret, nil
+}
+
+func (d *yamlDecoder) sequence(indent int) (interface{}, error) {
+	ret := []interface{}{}
+	for line := d.peek(); nil != line && indent == line.indent && isYAMLSeqItem(line.text); line = d.peek() {
+		rest := strings.TrimLeft(line.text[1:], " ")
+		if _, _, ok := splitYAMLKeyValue(rest); ok || isYAMLSeqItem(rest) {
+			// 序列项内容是映射或者序列时把 - 后面的内容当作缩进更深的一行
+			line.indent += len(line.text) - len(rest)
+			line.text = rest
+			v, err := d.block(line.indent)
+			if nil != err {
+				return nil, err
+			}
+			ret = append(ret, v)
+			continue
+		}
+
+		d.pos++
+		v, err := d.value(line, indent, rest, false)
+		if nil != err {
+			return nil, err
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+// value 解析 line 中 : 或者 - 后面的值 value，value 为空时值在后续缩进更深的行中。
+// inMapping 为 true 时允许映射值是和键缩进相同的序列。
+func (d *yamlDecoder) value(line *yamlLine, indent int, value string, inMapping bool) (interface{}, error) {
+	value = stripYAMLComment(value)
+	if "" == value {
+		next := d.peek()
+		if nil != next && (next.indent > indent || (inMapping && next.indent == indent && isYAMLSeqItem(next.text))) {
+			return d.block(next.indent)
+		}
+		return nil, nil
+	}
+
+	if '|' == value[0] || '>' == value[0] {
+		return d.blockScalar(line, indent, value)
+	}
+	return parseYAMLScalar(line, value)
+}
+
+// blockScalar 解析 | 或者 > 开头的块标量，header 为块标量头。
+func (d *yamlDecoder) blockScalar(line *yamlLine, indent int, header string) (interface{}, error) {
+	chomping := header[1:]
+	if "" != chomping && "-" != chomping && "+" != chomping {
+		return nil, yamlError(line, "unsupported block scalar header")
+	}
+
+	var lines []string
+	contentIndent := -1
+	for ; d.pos < len(d.lines); d.pos++ {
+		l := d.lines[d.pos]
+		if "" == l.raw {
+			lines = append(lines, "")
+			continue
+		}
+		if l.indent <= indent {
+			break
+		}
+		if 0 > contentIndent {
+			contentIndent = l.indent
+		}
+		if l.indent < contentIndent {
+			return nil, yamlError(l, "bad indentation of a block scalar")
+		}
+		lines = append(lines, l.raw[contentIndent:])
+	}
+
+	content := ""
+	if '|' == header[0] {
+		content = strings.Join(lines, "\n")
+	} else {
+		for i, l := range lines {
+			if 0 < i {
+				if "" == l || "" == lines[i-1] {
+					content += "\n"
+				} else {
+					content += " "
+				}
+			}
+			content += l
+		}
+	}
+
+	body := strings.TrimRight(content, "\n")
+	switch chomping {
+	case "-":
+		return body, nil
+	case "+":
+		return content + "\n", nil
+	}
+	if "" == body {
+		return "", nil
+	}
+	return body + "\n", nil
+}
+
+func isYAMLSeqItem(text string) bool {
+	return "-" == text || strings.HasPrefix(text, "- ")
+}
+
+// splitYAMLKeyValue 把 key: value 拆分为键和值，不是键值对时 ok 为 false。
+func splitYAMLKeyValue(text string) (key, value string, ok bool) {
+	if "" == text || '[' == text[0] || '{' == text[0] {
+		return
+	}
+
+	start := 0
+	if '"' == text[0] || '\'' == text[0] {
+		end := closingQuote(text)
+		if 0 > end {
+			return
+		}
+		start = end + 1
+	}
+	for i := start; i < len(text); i++ {
+		if ':' != text[i] {
+			continue
+		}
+		if i+1 == len(text) || ' ' == text[i+1] {
+			return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:]), true
+		}
+		if 0 < start {
+			return
+		}
+	}
+	return
+}
+
+// stripYAMLComment 去掉 s 中 # 开始的注释，引号字符串中的 # 不是注释。
+func stripYAMLComment(s string) string {
+	start := 0
+	if "" != s && ('"' == s[0] || '\'' == s[0]) {
+		if start = closingQuote(s); 0 > start {
+			return s
+		}
+	}
+	for i := start; i < len(s); i++ {
+		if '#' == s[i] && (0 == i || ' ' == s[i-1] || '\t' == s[i-1]) {
+			return strings.TrimSpace(s[:i])
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+func parseYAMLScalar(line *yamlLine, s string) (interface{}, error) {
+	switch {
+	case "" == s:
+		return nil, nil
+	case '"' == s[0] || '\'' == s[0]:
+		if len(s)-1 != closingQuote(s) {
+			return nil, yamlError(line, "unterminated quoted string")
+		}
+		if '\'' == s[0] {
+			return strings.Replace(s[1:len(s)-1], "''", "'", -1), nil
+		}
+		ret, err := unescapeQuoted(s[1 : len(s)-1])
+		if nil != err {
+			return nil, yamlError(line, err.Error())
+		}
+		return ret, nil
+	case '[' == s[0]:
+		if ']' != s[len(s)-1] {
+			return nil, yamlError(line, "unterminated flow sequence")
+		}
+		ret := []interface{}{}
+		for _, item := range splitFlowItems(s[1 : len(s)-1]) {
+			v, err := parseYAMLScalar(line, item)
+			if nil != err {
+				return nil, err
+			}
+			ret = append(ret, v)
+		}
+		return ret, nil
+	case '{' == s[0]:
+		if '}' != s[len(s)-1] {
+			return nil, yamlError(line, "unterminated flow mapping")
+		}
+		ret := map[string]interface{}{}
+		for _, item := range splitFlowItems(s[1 : len(s)-1]) {
+			key, value, ok := splitYAMLKeyValue(item)
+			if !ok {
+				return nil, yamlError(line, "could not find expected ':'")
+			}
+			k, err := parseYAMLScalar(line, key)
+			if nil != err {
+				return nil, err
+			}
+			v, err := parseYAMLScalar(line, value)
+			if nil != err {
+				return nil, err
+			}
+			ret[yamlKeyString(k)] = v
+		}
+		return ret, nil
+	}
+
+	switch s {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if isNumberLike(s) {
+		if i, err := strconv.ParseInt(s, 0, 64); nil == err {
+			if int64(int(i)) == i {
+				return int(i), nil
+			}
+			return i, nil
+		}
+		if f, err := strconv.ParseFloat(s, 64); nil == err {
+			return f, nil
+		}
+	}
+	return s, nil
+}
+
+// yamlKeyString 把解析后的键转换为字符串。
+func yamlKeyString(key interface{}) string {
+	switch k := key.(type) {
+	case string:
+		return k
+	case nil:
+		return "null"
+	case float64:
+		return strconv.FormatFloat(k, 'g', -1, 64)
+	default:
+		b, _ := json.Marshal(k)
+		return string(b)
+	}
+}
+
+func yamlError(line *yamlLine, msg string) error {
+	return errors.New("yaml: line " + strconv.Itoa(line.num) + ": " + msg)
+}
+
+func decodeTOML(data string) (ret map[string]interface{}, err error) {
+	ret = map[string]interface{}{}
+	current := ret
+	lines := strings.Split(data, "\n")
+	for i := 0; i < len(lines); i++ {
+		num := i + 1
+		line := strings.TrimSpace(stripTOMLComment(lines[i]))
+		if "" == line {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[[") {
+			if !strings.HasSuffix(line, "]]") {
+				return nil, tomlError(num, "unterminated table array header")
+			}
+			keys, err := parseTOMLKey(line[2 : len(line)-2])
+			if nil != err {
+				return nil, tomlError(num, err.Error())
+			}
+			parent, err := tomlTable(ret, keys[:len(keys)-1])
+			if nil != err {
+				return nil, tomlError(num, err.Error())
+			}
+			last := keys[len(keys)-1]
+			tables, ok := parent[last].([]map[string]interface{})
+			if nil != parent[last] && !ok {
+				return nil, tomlError(num, "key ["+last+"] is already defined")
+			}
+			current = map[string]interface{}{}
+			parent[last] = append(tables, current)
+			continue
+		}
+
+		if '[' == line[0] {
+			if ']' != line[len(line)-1] {
+				return nil, tomlError(num, "unterminated table header")
+			}
+			keys, err := parseTOMLKey(line[1 : len(line)-1])
+			if nil != err {
+				return nil, tomlError(num, err.Error())
+			}
+			if current, err = tomlTable(ret, keys); nil != err {
+				return nil, tomlError(num, err.Error())
+			}
+			continue
+		}
+
+		eq := indexOutsideQuotes(line, '=')
+		if 0 > eq {
+			return nil, tomlError(num, "expected key = value")
+		}
+		keys, err := parseTOMLKey(line[:eq])
+		if nil != err {
+			return nil, tomlError(num, err.Error())
+		}
+		value := strings.TrimSpace(line[eq+1:])
+		multilineString := strings.HasPrefix(value, `"""`) || strings.HasPrefix(value, "'''")
+		if multilineString {
+			// 多行字符串中的 # 不是注释，需要使用原始内容
+			raw := strings.TrimRight(lines[i], "\r")
+			value = strings.TrimSpace(raw[indexOutsideQuotes(raw, '=')+1:])
+		}
+		for !isTOMLValueComplete(value) && i+1 < len(lines) {
+			i++
+			if multilineString {
+				value += "\n" + strings.TrimRight(lines[i], "\r")
+			} else {
+				value += "\n" + stripTOMLComment(lines[i])
+			}
+		}
+		if multilineString {
+			if end := strings.Index(value[3:], value[:3]); 0 <= end {
+				end += 6
+				if rest := strings.TrimSpace(value[end:]); "" != rest && '#' != rest[0] {
+					return nil, tomlError(num, "unexpected content after multi-line string")
+				}
+				value = value[:end]
+			}
+		}
+
+		v, err := parseTOMLValue(value)
+		if nil != err {
+			return nil, tomlError(num, err.Error())
+		}
+		table, err := tomlTable(current, keys[:len(keys)-1])
+		if nil != err {
+			return nil, tomlError(num, err.Error())
+		}
+		last := keys[len(keys)-1]
+		if _, ok := table[last]; ok {
+			return nil, tomlError(num, "key ["+last+"] is already defined")
+		}
+		table[last] = v
+	}
+	return
+}
+
+// tomlTable 返回 root 下按 keys 逐级查找的表，不存在时创建，遇到表数组时进入最后一个表。
+func tomlTable(root map[string]interface{}, keys []string) (ret map[string]interface{}, err error) {
+	ret = root
+	for _, key := range keys {
+		switch v := ret[key].(type) {
+		case nil:
+			table := map[string]interface{}{}
+			ret[key] = table
+			ret = table
+		case map[string]interface{}:
+			ret = v
+		case []map[string]interface{}:
+			ret = v[len(v)-1]
+		default:
+			return nil, errors.New("key [" + key + "] is not a table")
+		}
+	}
+	return
+}
+
+// parseTOMLKey 解析点分键。
+func parseTOMLKey(s string) (ret []string, err error) {
+	for _, part := range splitOutsideQuotes(s, '.') {
+		part = strings.TrimSpace(part)
+		switch {
+		case "" == part:
+			return nil, errors.New("empty key")
+		case '"' == part[0] || '\'' == part[0]:
+			v, err := parseTOMLValue(part)
+			if nil != err {
+				return nil, err
+			}
+			ret = append(ret, v.(string))
+		default:
+			for i := 0; i < len(part); i++ {
+				if c := part[i]; !isASCIILetterNum(c) && '_' != c && '-' != c {
+					return nil, errors.New("invalid bare key [" + part + "]")
+				}
+			}
+			ret = append(ret, part)
+		}
+	}
+	return
+}
+
+func parseTOMLValue(s string) (interface{}, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case "" == s:
+		return nil, errors.New("missing value")
+	case strings.HasPrefix(s, `"""`) || strings.HasPrefix(s, "'''"):
+		delimiter := s[:3]
+		if 6 > len(s) || !strings.HasSuffix(s, delimiter) {
+			return nil, errors.New("unterminated multi-line string")
+		}
+		content := strings.Replace(s[3:len(s)-3], "\r\n", "\n", -1)
+		content = strings.TrimPrefix(content, "\n")
+		if "'''" == delimiter {
+			return content, nil
+		}
+		return unescapeQuoted(content)
+	case '"' == s[0] || '\'' == s[0]:
+		if len(s)-1 != closingQuote(s) {
+			return nil, errors.New("unterminated string")
+		}
+		if '\'' == s[0] {
+			return s[1 : len(s)-1], nil
+		}
+		return unescapeQuoted(s[1 : len(s)-1])
+	case '[' == s[0]:
+		if ']' != s[len(s)-1] {
+			return nil, errors.New("unterminated array")
+		}
+		ret := []interface{}{}
+		for _, item := range splitFlowItems(s[1 : len(s)-1]) {
+			v, err := parseTOMLValue(item)
+			if nil != err {
+				return nil, err
+			}
+			ret = append(ret, v)
+		}
+		return ret, nil
+	case '{' == s[0]:
+		if '}' != s[len(s)-1] {
+			return nil, errors.New("unterminated inline table")
+		}
+		ret := map[string]interface{}{}
+		for _, item := range splitFlowItems(s[1 : len(s)-1]) {
+			eq := indexOutsideQuotes(item, '=')
+			if 0 > eq {
+				return nil, errors.New("expected key = value")
+			}
+			keys, err := parseTOMLKey(item[:eq])
+			if nil != err {
+				return nil, err
+			}
+			v, err := parseTOMLValue(item[eq+1:])
+			if nil != err {
+				return nil, err
+			}
+			table, err := tomlTable(ret, keys[:len(keys)-1])
+			if nil != err {
+				return nil, err
+			}
+			table[keys[len(keys)-1]] = v
+		}
+		return ret, nil
+	case "true" == s:
+		return true, nil
+	case "false" == s:
+		return false, nil
+	}
+
+	if isTOMLDateTime(s) {
+		return s, nil
+	}
+	number := strings.Replace(s, "_", "", -1)
+	if i, err := strconv.ParseInt(number, 0, 64); nil == err && isNumberLike(number) {
+		return i, nil
+	}
+	switch number {
+	case "inf", "+inf", "-inf", "nan", "+nan", "-nan":
+		number = strings.Replace(number, "inf", "Inf", 1)
+		number = strings.Replace(number, "nan", "NaN", 1)
+	}
+	if f, err := strconv.ParseFloat(number, 64); nil == err {
+		return f, nil
+	}
+	return nil, errors.New("invalid value [" + s + "]")
+}
+
+// isTOMLDateTime 判断 s 是否是 TOML 的日期时间，比如 1979-05-27、07:32:00 或者 1979-05-27T07:32:00Z。
+func isTOMLDateTime(s string) bool {
+	if 10 <= len(s) && isDigit(s[0]) && isDigit(s[1]) && isDigit(s[2]) && isDigit(s[3]) && '-' == s[4] {
+		return true
+	}
+	return 8 <= len(s) && isDigit(s[0]) && isDigit(s[1]) && ':' == s[2]
+}
+
+// isTOMLValueComplete 判断值是否完整，多行数组、内联表和多行字符串需要继续读取后续行。
+func isTOMLValueComplete(value string) bool {
+	if strings.HasPrefix(value, `"""`) || strings.HasPrefix(value, "'''") {
+		return 0 <= strings.Index(value[3:], value[:3])
+	}
+
+	depth := 0
+	var quote byte
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 0 != quote:
+			if '\\' == c && '"' == quote {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case '"' == c || '\'' == c:
+			quote = c
+		case '[' == c || '{' == c:
+			depth++
+		case ']' == c || '}' == c:
+			depth--
+		}
+	}
+	return 0 >= depth
+}
+
+// stripTOMLComment 去掉 s 中引号外 # 开始的注释。
+func stripTOMLComment(s string) string {
+	if i := indexOutsideQuotes(s, '#'); 0 <= i {
+		return s[:i]
+	}
+	return strings.TrimRight(s, "\r")
+}
+
+func tomlError(num int, msg string) error {
+	return errors.New("toml: line " + strconv.Itoa(num) + ": " + msg)
+}
+
+// closingQuote 返回 s 开头的引号对应的结束引号位置，没有时返回 -1。
+func closingQuote(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		if '\\' == s[i] && '"' == quote {
+			i++
+			continue
+		}
+		if quote == s[i] {
+			if '\'' == quote && i+1 < len(s) && '\'' == s[i+1] {
+				// YAML 单引号字符串中 '' 表示 '
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// indexOutsideQuotes 返回 s 中引号外第一个 c 的位置，没有时返回 -1。
+func indexOutsideQuotes(s string, c byte) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch {
+		case 0 != quote:
+			if '\\' == s[i] && '"' == quote {
+				i++
+			} else if quote == s[i] {
+				quote = 0
+			}
+		case '"' == s[i] || '\'' == s[i]:
+			quote = s[i]
+		case c == s[i]:
+			return i
+		}
+	}
+	return -1
+}
+
+// splitOutsideQuotes 使用引号外的 sep 拆分 s。
+func splitOutsideQuotes(s string, sep byte) (ret []string) {
+	for i := indexOutsideQuotes(s, sep); 0 <= i; i = indexOutsideQuotes(s, sep) {
+		ret = append(ret, s[:i])
+		s = s[i+1:]
+	}
+	return append(ret, s)
+}
+
+// splitFlowItems 使用最外层的逗号拆分 [] 或者 {} 中的内容，忽略空项以支持末尾的逗号。
+func splitFlowItems(s string) (ret []string) {
+	depth, start := 0, 0
+	var quote byte
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || (0 == quote && 0 == depth && ',' == s[i]) {
+			if item := strings.TrimSpace(s[start:i]); "" != item {
+				ret = append(ret, item)
+			}
+			start = i + 1
+			continue
+		}
+
+		c := s[i]
+		switch {
+		case 0 != quote:
+			if '\\' == c && '"' == quote {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case '"' == c || '\'' == c:
+			quote = c
+		case '[' == c || '{' == c:
+			depth++
+		case ']' == c || '}' == c:
+			depth--
+		}
+	}
+	return
+}
+
+// isNumberLike 判断 s 是否以数字、正负号或者小数点开头且不是 inf、nan。
+func isNumberLike(s string) bool {
+	if !isDigit(s[0]) && '+' != s[0] && '-' != s[0] && '.' != s[0] {
+		return false
+	}
+	lower := strings.ToLower(s)
+	return !strings.Contains(lower, "inf") && !strings.Contains(lower, "nan")
+}
+
+// unescapeQuoted 处理双引号字符串中的转义序列。
+func unescapeQuoted(s string) (string, error) {
+	if 0 > strings.IndexByte(s, '\\') {
+		return s, nil
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if '\\' != c {
+			buf.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", errors.New("invalid escape sequence")
+		}
+		switch s[i] {
+		case 'n':
+			buf.WriteByte('\n')
+		case 't':
+			buf.WriteByte('\t')
+		case 'r':
+			buf.WriteByte('\r')
+		case 'b':
+			buf.WriteByte('\b')
+		case 'f':
+			buf.WriteByte('\f')
+		case '0':
+			buf.WriteByte(0)
+		case '"', '\\', '/', '\'', ' ':
+			buf.WriteByte(s[i])
+		case '\n':
+			// 行尾的 \ 会去掉换行和下一行开头的空白
+			for i+1 < len(s) && (' ' == s[i+1] || '\t' == s[i+1] || '\n' == s[i+1]) {
+				i++
+			}
+		case 'x', 'u', 'U':
+			size := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[i]]
+			if i+size >= len(s) {
+				return "", errors.New("invalid escape sequence")
+			}
+			code, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32)
+			if nil != err || !utf8.ValidRune(rune(code)) {
+				return "", errors.New("invalid escape sequence")
+			}
+			buf.WriteRune(rune(code))
+			i += size
+		default:
+			return "", errors.New("invalid escape sequence")
+		}
+	}
+	return buf.String(), nil
+}
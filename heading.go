@@ -17,7 +17,15 @@ import "bytes"
 // Heading 描述了标题节点结构。
 type Heading struct {
 	*BaseNode
-	Level int // 1~6
+	Level    int   // 1~6
+	ID       items // 标题 id，启用 HeadingID 或者 TOC 时生成
+	CustomID items // 通过 {#id} 自定义的标题 id
+}
+
+func (heading *Heading) Finalize(context *Context) {
+	if context.option.HeadingID || context.option.TOC { // 启用目录时也会生成标题 id
+		heading.tokens, heading.CustomID = parseHeadingID(heading.tokens)
+	}
 }
 
 func (heading *Heading) Continue(context *Context) int {
@@ -44,9 +52,12 @@ func (t *Tree) parseATXHeading() (ret *Heading) {
 		return
 	}
 
-	heading := &Heading{&BaseNode{typ: NodeHeading}, level}
+	heading := &Heading{BaseNode: &BaseNode{typ: NodeHeading}, Level: level}
 	tokens = bytes.TrimLeft(tokens, " \t\n")
 	tokens = bytes.TrimLeft(tokens[level:], " \t\n")
+	heading.lines = &lineMap{}
+	start := t.context.currentLineLen - len(tokens)
+	heading.lines.add(0, t.context.lineNum, start+1, t.context.lineOffset+start)
 	for _, token := range tokens {
 		if itemEnd == token || itemNewline == token {
 			break
@@ -117,7 +128,7 @@ func (t *Tree) parseSetextHeading() (ret *Heading) {
 		return nil
 	}
 
-	ret = &Heading{&BaseNode{typ: NodeHeading}, 1}
+	ret = &Heading{BaseNode: &BaseNode{typ: NodeHeading}, Level: 1}
 	if itemHyphen == marker {
 		ret.Level = 2
 	}
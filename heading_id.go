@@ -0,0 +1,114 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Slugger 描述了标题 id 生成函数签名，text 为标题的纯文本内容。
+// 同一文档中重复的 id 会统一加上 -1、-2 这样的后缀进行去重，所以 Slugger 不需要处理重复问题。
+type Slugger func(text string) string
+
+// Slug 是默认的标题 id 生成函数：转为小写，保留字母（包括汉字等 CJK 字符）、数字、- 和 _，空白替换为 -，去掉其他标点符号。
+func Slug(text string) string {
+	var buf strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || '-' == r || '_' == r {
+			buf.WriteRune(r)
+		} else if unicode.IsSpace(r) {
+			buf.WriteByte('-')
+		}
+	}
+	return buf.String()
+}
+
+// parseHeadingID 解析标题内容 tokens 结尾的自定义 id {#id}，返回去掉自定义 id 后的内容以及 id，没有自定义 id 时 id 返回 nil。
+func parseHeadingID(tokens items) (content, id items) {
+	length := len(tokens)
+	if 4 > length || '}' != tokens[length-1] {
+		return tokens, nil
+	}
+
+	start := bytes.LastIndex(tokens, []byte("{#"))
+	if 0 > start || (0 < start && !isWhitespace(tokens[start-1])) {
+		return tokens, nil
+	}
+	id = tokens[start+2 : length-1]
+	if 1 > len(id) || bytes.ContainsAny(id, " \t\n{}") {
+		return tokens, nil
+	}
+	return bytes.TrimRight(tokens[:start], " \t"), id
+}
+
+// generateHeadingIDs 按文档顺序为所有标题生成 id。自定义 id 优先使用，生成的 id 会避开所有自定义 id 以及之前使用过的 id，
+// 重复的自定义 id 和生成的 id 一样通过 -1、-2 这样的后缀去重。
+func (t *Tree) generateHeadingIDs() {
+	slugger := t.context.option.headingSlugger
+	if nil == slugger {
+		slugger = Slug
+	}
+
+	var headings []*Heading
+	reserved := map[string]bool{}
+	Walk(t.Root, func(n Node, entering bool) (WalkStatus, error) {
+		if heading, ok := n.(*Heading); entering && ok {
+			headings = append(headings, heading)
+			if nil != heading.CustomID {
+				reserved[fromItems(heading.CustomID)] = true
+			}
+			return WalkSkipChildren, nil
+		}
+		return WalkContinue, nil
+	})
+
+	used := map[string]bool{}
+	for _, heading := range headings {
+		if nil != heading.CustomID && !used[fromItems(heading.CustomID)] {
+			heading.ID = heading.CustomID
+			used[fromItems(heading.CustomID)] = true
+			continue
+		}
+
+		slug := fromItems(heading.CustomID)
+		if nil == heading.CustomID {
+			slug = slugger(nodeText(heading))
+		}
+		if "" == slug {
+			slug = "heading"
+		}
+		id := slug
+		for i := 1; used[id] || reserved[id]; i++ {
+			id = slug + "-" + strconv.Itoa(i)
+		}
+		used[id] = true
+		heading.ID = items(id)
+	}
+}
+
+// nodeText 返回节点 node 的纯文本内容，即所有叶子节点（HTML 除外）内容的拼接。
+func nodeText(node Node) string {
+	var buf bytes.Buffer
+	Walk(node, func(n Node, entering bool) (WalkStatus, error) {
+		if entering && nil == n.FirstChild() {
+			if typ := n.Type(); NodeInlineHTML != typ && NodeHTMLBlock != typ {
+				buf.Write(n.Tokens())
+			}
+		}
+		return WalkContinue, nil
+	})
+	return buf.String()
+}
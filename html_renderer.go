@@ -15,6 +15,9 @@ package lute
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/alecthomas/chroma"
 	chromahtml "github.com/alecthomas/chroma/formatters/html"
 	chromalexers "github.com/alecthomas/chroma/lexers"
@@ -23,7 +26,7 @@ import (
 
 // newHTMLRenderer 创建一个 HTML 渲染器。
 func newHTMLRenderer(option options) (ret *Renderer) {
-	ret = &Renderer{rendererFuncs: map[int]RendererFunc{}, option: option}
+	ret = &Renderer{rendererFuncs: map[int]RendererFunc{}, extRendererFuncs: option.htmlRendererFuncs, option: option}
 
 	// 注册 CommonMark 渲染函数
 
@@ -55,9 +58,244 @@ func newHTMLRenderer(option options) (ret *Renderer) {
 	ret.rendererFuncs[NodeTableRow] = ret.renderTableRowHTML
 	ret.rendererFuncs[NodeTableCell] = ret.renderTableCellHTML
 
+	// 注册脚注渲染函数
+
+	ret.rendererFuncs[NodeFootnotesDef] = ret.renderFootnotesDefHTML
+	ret.rendererFuncs[NodeFootnotesRef] = ret.renderFootnotesRefHTML
+
+	// 注册数学公式渲染函数
+
+	ret.rendererFuncs[NodeMathBlock] = ret.renderMathBlockHTML
+	ret.rendererFuncs[NodeInlineMath] = ret.renderInlineMathHTML
+
+	// 注册目录渲染函数
+
+	ret.rendererFuncs[NodeTOC] = ret.renderTOCHTML
+
+	// 注册元数据块渲染函数
+
+	ret.rendererFuncs[NodeFrontMatter] = ret.renderFrontMatterHTML
+
+	// 注册 Emoji 渲染函数
+
+	ret.rendererFuncs[NodeEmoji] = ret.renderEmojiHTML
+
+	// 注册提及和标签渲染函数
+
+	ret.rendererFuncs[NodeMention] = ret.renderMentionHTML
+	ret.rendererFuncs[NodeHashtag] = ret.renderHashtagHTML
+
 	return
 }
 
+func (r *Renderer) renderMentionHTML(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.renderResolvedLinkHTML(node, r.option.mentionResolver, node.(*Mention).Name(), "mention")
+	}
+	return WalkContinue, nil
+}
+
+func (r *Renderer) renderHashtagHTML(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.renderResolvedLinkHTML(node, r.option.hashtagResolver, node.(*Hashtag).Name(), "hashtag")
+	}
+	return WalkContinue, nil
+}
+
+// renderResolvedLinkHTML 使用 resolver 解析 name 的链接地址，解析成功时将节点 node 渲染为 class 为 class 的链接，否则渲染为普通文本。
+func (r *Renderer) renderResolvedLinkHTML(node Node, resolver LinkResolveFunc, name, class string) {
+	var dest []byte
+	if nil != resolver {
+		dest = resolver(name)
+	}
+	if nil == dest {
+		r.Write(escapeHTML(node.Tokens()))
+		return
+	}
+
+	r.Tag("a", [][]string{{"href", fromItems(escapeHTML(dest))}, {"class", class}}, false)
+	r.Write(escapeHTML(node.Tokens()))
+	r.Tag("/a", nil, false)
+}
+
+func (r *Renderer) renderEmojiHTML(node Node, entering bool) (WalkStatus, error) {
+	if !entering {
+		return WalkContinue, nil
+	}
+
+	alias := fromItems(node.Tokens())
+	value, ok := lookupEmoji(r.option.emojis, alias)
+	if !ok || (isEmojiImage(value) && 0 < r.disableTags) {
+		// 未知的 Emoji 以及图片 alt 中的图片 Emoji 原样输出短码
+		r.WriteString(":" + alias + ":")
+		return WalkContinue, nil
+	}
+
+	if isEmojiImage(value) {
+		alias = fromItems(escapeHTML(toItems(alias)))
+		src := fromItems(escapeHTML(toItems(emojiImageSrc(r.option.EmojiSite, value))))
+		r.Tag("img", [][]string{{"alt", alias}, {"class", "emoji"}, {"src", src}, {"title", alias}}, true)
+	} else {
+		r.WriteString(value)
+	}
+	return WalkContinue, nil
+}
+
+func (r *Renderer) renderFrontMatterHTML(node Node, entering bool) (WalkStatus, error) {
+	// 元数据不输出到 HTML 中
+	return WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderTOCHTML(node Node, entering bool) (WalkStatus, error) {
+	if !entering {
+		return WalkContinue, nil
+	}
+
+	root := node
+	for nil != root.Parent() {
+		root = root.Parent()
+	}
+	items := toc(root, r.option.TOCMinLevel, r.option.TOCMaxLevel)
+	if 1 > len(items) {
+		return WalkContinue, nil
+	}
+
+	r.Newline()
+	r.Tag("div", append([][]string{{"class", "toc"}}, r.sourcePos(node)...), false)
+	r.writeByte('\n')
+	r.renderTOCItemsHTML(items)
+	r.WriteString("</div>\n")
+	return WalkContinue, nil
+}
+
+// renderTOCItemsHTML 将目录项 items 渲染为嵌套的列表。
+func (r *Renderer) renderTOCItemsHTML(items []*TOCItem) {
+	r.WriteString("<ul>\n")
+	for _, item := range items {
+		r.WriteString("<li>")
+		if "" != item.ID {
+			r.Tag("a", [][]string{{"href", "#" + fromItems(escapeHTML(toItems(item.ID)))}}, false)
+			r.Write(escapeHTML(toItems(item.Text)))
+			r.Tag("/a", nil, false)
+		} else {
+			r.Write(escapeHTML(toItems(item.Text)))
+		}
+		if 0 < len(item.Children) {
+			r.writeByte('\n')
+			r.renderTOCItemsHTML(item.Children)
+		}
+		r.WriteString("</li>\n")
+	}
+	r.WriteString("</ul>\n")
+}
+
+func (r *Renderer) renderInlineMathHTML(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.renderMathHTML("span", node.Tokens(), false)
+	}
+	return WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderMathBlockHTML(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		r.Newline()
+		r.renderMathHTML("div", node.Tokens(), true)
+		r.Newline()
+	}
+	return WalkSkipChildren, nil
+}
+
+// renderMathHTML 渲染公式 tex。如果设置了服务端转换函数则输出转换结果，否则使用 tag 包裹公式源码，由客户端进行渲染。
+func (r *Renderer) renderMathHTML(tag string, tex items, displayMode bool) {
+	if nil != r.option.mathConverter {
+		if html, err := r.option.mathConverter(tex, displayMode); nil == err {
+			r.Write(html)
+			return
+		}
+	}
+
+	r.Tag(tag, [][]string{{"class", "language-math"}}, false)
+	r.Write(escapeHTML(tex))
+	r.Tag("/"+tag, nil, false)
+}
+
+func (r *Renderer) renderFootnotesRefHTML(node Node, entering bool) (WalkStatus, error) {
+	if entering {
+		n := node.(*FootnotesRef)
+		var def *FootnotesDef
+		if nil != r.footnotes {
+			def = r.footnotes.refDefs[n]
+		}
+		if nil == def {
+			r.WriteString("[^")
+			r.Write(escapeHTML(n.Label))
+			r.writeByte(']')
+			return WalkContinue, nil
+		}
+
+		num := strconv.Itoa(r.footnotes.nums[def])
+		r.WriteString("<sup class=\"footnote-ref\"><a href=\"#fn" + num + "\" id=\"" + footnotesRefID(num, r.footnotes.refs[n]) + "\">" + num + "</a></sup>")
+	}
+	return WalkContinue, nil
+}
+
+func (r *Renderer) renderFootnotesDefHTML(node Node, entering bool) (WalkStatus, error) {
+	// 脚注定义不在原位置渲染，而是在文档末尾统一渲染，具体实现可参考函数 renderFootnotesHTML()
+	return WalkSkipChildren, nil
+}
+
+// renderFootnotesHTML 在文档末尾按编号渲染所有被引用过的脚注定义。
+func (r *Renderer) renderFootnotesHTML() error {
+	if 1 > len(r.footnotes.defs) {
+		return nil
+	}
+
+	r.Newline()
+	r.WriteString("<section class=\"footnotes\">\n<ol>\n")
+	for i, def := range r.footnotes.defs {
+		r.WriteString("<li id=\"fn" + strconv.Itoa(i+1) + "\">\n")
+		for child := def.FirstChild(); nil != child; child = child.Next() {
+			if err := Walk(child, r.renderNode); nil != err {
+				return err
+			}
+		}
+		if last := def.LastChild(); nil == last || NodeParagraph != last.Type() {
+			// 最后一个子节点不是段落的话需要单独生成一个段落来放置返回链接
+			r.Newline()
+			r.WriteString("<p>")
+			r.renderFootnotesBackrefsHTML(def, false)
+			r.WriteString("</p>\n")
+		}
+		r.Newline()
+		r.WriteString("</li>\n")
+	}
+	r.WriteString("</ol>\n</section>\n")
+	return nil
+}
+
+// renderFootnotesBackrefsHTML 渲染脚注定义 def 指向所有引用处的返回链接，space 为 true 时在第一个链接前插入空格。
+func (r *Renderer) renderFootnotesBackrefsHTML(def *FootnotesDef, space bool) {
+	num := strconv.Itoa(r.footnotes.nums[def])
+	for i := 1; i <= r.footnotes.refCount[def]; i++ {
+		if 1 < i || space {
+			r.writeByte(' ')
+		}
+		r.WriteString("<a href=\"#" + footnotesRefID(num, i) + "\" class=\"footnote-backref\">↩")
+		if 1 < i {
+			r.WriteString("<sup>" + strconv.Itoa(i) + "</sup>")
+		}
+		r.WriteString("</a>")
+	}
+}
+
+// footnotesRefID 返回编号为 num 的脚注的第 i 次引用的元素 id。
+func footnotesRefID(num string, i int) string {
+	if 1 < i {
+		return "fnref" + num + "-" + strconv.Itoa(i)
+	}
+	return "fnref" + num
+}
+
 func (r *Renderer) renderTableCellHTML(node Node, entering bool) (WalkStatus, error) {
 	tag := "td"
 	if NodeTableHead == node.Parent().Type() {
@@ -74,64 +312,64 @@ func (r *Renderer) renderTableCellHTML(node Node, entering bool) (WalkStatus, er
 		case 3:
 			attrs = append(attrs, []string{"align", "right"})
 		}
-		r.tag(tag, attrs, false)
+		r.Tag(tag, attrs, false)
 	} else {
-		r.tag("/"+tag, nil, false)
-		r.newline()
+		r.Tag("/"+tag, nil, false)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderTableRowHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("tr", nil, false)
-		r.newline()
+		r.Tag("tr", nil, false)
+		r.Newline()
 	} else {
-		r.tag("/tr", nil, false)
-		r.newline()
+		r.Tag("/tr", nil, false)
+		r.Newline()
 		if node == node.Parent().LastChild() {
-			r.tag("/tbody", nil, false)
+			r.Tag("/tbody", nil, false)
 		}
-		r.newline()
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderTableHeadHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("thead", nil, false)
-		r.newline()
-		r.tag("tr", nil, false)
-		r.newline()
+		r.Tag("thead", nil, false)
+		r.Newline()
+		r.Tag("tr", nil, false)
+		r.Newline()
 	} else {
-		r.tag("/tr", nil, false)
-		r.newline()
-		r.tag("/thead", nil, false)
-		r.newline()
+		r.Tag("/tr", nil, false)
+		r.Newline()
+		r.Tag("/thead", nil, false)
+		r.Newline()
 		if nil != node.Next() {
-			r.tag("tbody", nil, false)
+			r.Tag("tbody", nil, false)
 		}
-		r.newline()
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderTableHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("table", nil, false)
-		r.newline()
+		r.Tag("table", r.sourcePos(node), false)
+		r.Newline()
 	} else {
-		r.tag("/table", nil, false)
-		r.newline()
+		r.Tag("/table", nil, false)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderStrikethroughHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("del", nil, false)
+		r.Tag("del", nil, false)
 	} else {
-		r.tag("/del", nil, false)
+		r.Tag("/del", nil, false)
 	}
 	return WalkContinue, nil
 }
@@ -140,9 +378,9 @@ func (r *Renderer) renderImageHTML(node Node, entering bool) (WalkStatus, error)
 	n := node.(*Image)
 	if entering {
 		if 0 == r.disableTags {
-			r.writeString("<img src=\"")
-			r.write(escapeHTML(n.Destination))
-			r.writeString("\" alt=\"")
+			r.WriteString("<img src=\"")
+			r.Write(escapeHTML(r.safeURL(n.Destination)))
+			r.WriteString("\" alt=\"")
 		}
 		r.disableTags++
 		return WalkContinue, nil
@@ -150,13 +388,13 @@ func (r *Renderer) renderImageHTML(node Node, entering bool) (WalkStatus, error)
 
 	r.disableTags--
 	if 0 == r.disableTags {
-		r.writeString("\"")
+		r.WriteString("\"")
 		if nil != n.Title {
-			r.writeString(" title=\"")
-			r.write(escapeHTML(n.Title))
-			r.writeString("\"")
+			r.WriteString(" title=\"")
+			r.Write(escapeHTML(n.Title))
+			r.WriteString("\"")
 		}
-		r.writeString(" />")
+		r.WriteString(" />")
 	}
 	return WalkContinue, nil
 }
@@ -164,16 +402,16 @@ func (r *Renderer) renderImageHTML(node Node, entering bool) (WalkStatus, error)
 func (r *Renderer) renderLinkHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
 		n := node.(*Link)
-		attrs := [][]string{{"href", fromItems(escapeHTML(n.Destination))}}
+		attrs := [][]string{{"href", fromItems(escapeHTML(r.safeURL(n.Destination)))}}
 		if nil != n.Title {
 			attrs = append(attrs, []string{"title", fromItems(escapeHTML(n.Title))})
 		}
-		r.tag("a", attrs, false)
+		r.Tag("a", attrs, false)
 
 		return WalkContinue, nil
 	}
 
-	r.tag("/a", nil, false)
+	r.Tag("/a", nil, false)
 	return WalkContinue, nil
 }
 
@@ -182,9 +420,9 @@ func (r *Renderer) renderHTMLHTML(node Node, entering bool) (WalkStatus, error)
 		return WalkContinue, nil
 	}
 
-	r.newline()
-	r.write(node.Tokens())
-	r.newline()
+	r.Newline()
+	r.Write(r.rawHTML(node.Tokens()))
+	r.Newline()
 	return WalkContinue, nil
 }
 
@@ -193,11 +431,45 @@ func (r *Renderer) renderInlineHTMLHTML(node Node, entering bool) (WalkStatus, e
 		return WalkContinue, nil
 	}
 
-	r.write(node.Tokens())
+	r.Write(r.rawHTML(node.Tokens()))
 	return WalkContinue, nil
 }
 
+// rawHTML 根据安全选项处理原始 HTML：启用 EscapeRawHTML 时转义，启用 SafeMode 时使用白名单过滤，启用 GFMTagFilter 时
+// 转义 GFM 不允许使用的标签，否则原样返回。
+func (r *Renderer) rawHTML(tokens items) items {
+	if r.option.EscapeRawHTML {
+		return escapeHTML(tokens)
+	}
+	if r.option.SafeMode {
+		tokens = sanitizeHTML(tokens, r.option.htmlAllowlist)
+	}
+	if r.option.GFMTagFilter {
+		tokens = filterGFMDisallowedTags(tokens)
+	}
+	return tokens
+}
+
+// safeURL 在启用 SafeMode 时检查链接地址 dest，不安全的地址返回空。
+func (r *Renderer) safeURL(dest items) items {
+	if r.option.SafeMode && !isSafeURL(dest) {
+		return nil
+	}
+	return dest
+}
+
 func (r *Renderer) renderDocumentHTML(node Node, entering bool) (WalkStatus, error) {
+	if !r.option.Footnotes {
+		return WalkContinue, nil
+	}
+
+	if entering {
+		r.footnotes = newFootnotes(node)
+	} else {
+		if err := r.renderFootnotesHTML(); nil != err {
+			return WalkStop, err
+		}
+	}
 	return WalkContinue, nil
 }
 
@@ -211,11 +483,14 @@ func (r *Renderer) renderParagraphHTML(node Node, entering bool) (WalkStatus, er
 	}
 
 	if entering {
-		r.newline()
-		r.tag("p", nil, false)
+		r.Newline()
+		r.Tag("p", r.sourcePos(node), false)
 	} else {
-		r.tag("/p", nil, false)
-		r.newline()
+		if def, ok := node.Parent().(*FootnotesDef); ok && def.LastChild() == node && nil != r.footnotes {
+			r.renderFootnotesBackrefsHTML(def, true)
+		}
+		r.Tag("/p", nil, false)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
@@ -225,31 +500,37 @@ func (r *Renderer) renderTextHTML(node Node, entering bool) (WalkStatus, error)
 		return WalkContinue, nil
 	}
 
-	r.write(escapeHTML(node.(*Text).tokens))
+	r.Write(escapeHTML(node.(*Text).tokens))
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderCodeSpanHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.writeString("<code>")
-		r.write(escapeHTML(node.Tokens()))
+		r.WriteString("<code>")
+		r.Write(escapeHTML(node.Tokens()))
 		return WalkSkipChildren, nil
 	}
-	r.writeString("</code>")
+	r.WriteString("</code>")
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderCodeBlockHTMl(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.newline()
+		r.Newline()
 		n := node.(*CodeBlock)
 		tokens := n.tokens
-		if nil != n.info {
-			infoWords := bytes.Fields(n.info)
-			language := infoWords[0]
-			r.writeString("<pre><code class=\"language-")
-			r.write(language)
-			r.writeString("\">")
+		info := parseCodeBlockInfo(n.info)
+		if 0 < len(info.title) {
+			// 有标题的代码块使用 figure 包裹，标题作为 figcaption
+			r.WriteString("<figure class=\"code-block\">\n<figcaption>")
+			r.Write(escapeHTML(info.title))
+			r.WriteString("</figcaption>\n")
+		}
+		if language := info.language; nil != language {
+			r.Tag("pre", r.preAttrs(node), false)
+			r.WriteString("<code class=\"language-")
+			r.Write(escapeHTML(language))
+			r.WriteString("\">")
 			rendered := false
 			if r.option.CodeSyntaxHighlight {
 				codeBlock := fromItems(tokens)
@@ -262,23 +543,12 @@ func (r *Renderer) renderCodeBlockHTMl(node Node, entering bool) (WalkStatus, er
 				if nil == lexer {
 					lexer = chromalexers.Fallback
 				}
-				iterator, err := lexer.Tokenise(nil, codeBlock)
-				if nil == err {
-					formatter := chromahtml.New(chromahtml.PreventSurroundingPre(), chromahtml.WithClasses(), chromahtml.ClassPrefix("highlight-"))
-					var b bytes.Buffer
-					if err = formatter.Format(&b, styles.GitHub, iterator); nil == err {
-						r.write(b.Bytes())
-						rendered = true
-						// 生成 CSS 临时调试用：
-						//formatter.WriteCSS(os.Stdout, styles.GitHub)
-						//os.Stdout.WriteString("\n")
-					}
-				}
+				rendered = r.highlightCode(lexer, codeBlock, info)
 			}
 
 			if !rendered {
 				tokens = escapeHTML(tokens)
-				r.write(tokens)
+				r.Write(tokens)
 			}
 		} else {
 			if r.option.CodeSyntaxHighlight {
@@ -288,64 +558,111 @@ func (r *Renderer) renderCodeBlockHTMl(node Node, entering bool) (WalkStatus, er
 					lexer = chromalexers.Fallback
 				}
 				language := lexer.Config().Name
-				r.writeString("<pre><code class=\"language-" + language + "\">")
-				rendered := false
-
-				iterator, err := lexer.Tokenise(nil, codeBlock)
-				if nil == err {
-					formatter := chromahtml.New(chromahtml.PreventSurroundingPre(), chromahtml.WithClasses(), chromahtml.ClassPrefix("highlight-"))
-					var b bytes.Buffer
-					if err = formatter.Format(&b, styles.GitHub, iterator); nil == err {
-						r.write(b.Bytes())
-						rendered = true
-					}
-				}
-
+				r.Tag("pre", r.preAttrs(node), false)
+				r.WriteString("<code class=\"language-" + language + "\">")
+				rendered := r.highlightCode(lexer, codeBlock, info)
 				if !rendered {
 					tokens = escapeHTML(tokens)
-					r.write(tokens)
+					r.Write(tokens)
 				}
 			} else {
-				r.writeString("<pre><code>")
+				r.Tag("pre", r.sourcePos(node), false)
+				r.WriteString("<code>")
 				tokens = escapeHTML(tokens)
-				r.write(tokens)
+				r.Write(tokens)
 			}
 		}
 		return WalkSkipChildren, nil
 	}
-	r.writeString("</code></pre>")
-	r.newline()
+	r.WriteString("</code></pre>")
+	if info := parseCodeBlockInfo(node.(*CodeBlock).info); 0 < len(info.title) {
+		r.WriteString("\n</figure>")
+	}
+	r.Newline()
 	return WalkContinue, nil
 }
 
+// preAttrs 返回代码块 node 的 pre 标签属性。使用内联样式进行语法高亮时，样式的前景色和背景色需要设置在 pre 上。
+func (r *Renderer) preAttrs(node Node) (ret [][]string) {
+	ret = r.sourcePos(node)
+	if !r.option.CodeSyntaxHighlight || !r.option.ChromaCodeInlineStyle {
+		return
+	}
+
+	background := styles.Get(r.option.ChromaCodeStyle).Get(chroma.Background)
+	var style []string
+	if background.Colour.IsSet() {
+		style = append(style, "color:"+background.Colour.String())
+	}
+	if background.Background.IsSet() {
+		style = append(style, "background-color:"+background.Background.String())
+	}
+	if 0 < len(style) {
+		ret = append(ret, []string{"style", strings.Join(style, ";")})
+	}
+	return
+}
+
+// highlightCode 使用 lexer 对代码 code 进行语法高亮并输出，info 中的行号和高亮行属性将传给 chroma，高亮失败时返回 false。
+func (r *Renderer) highlightCode(lexer chroma.Lexer, code string, info *codeBlockInfo) bool {
+	iterator, err := lexer.Tokenise(nil, code)
+	if nil != err {
+		return false
+	}
+
+	options := []chromahtml.Option{chromahtml.PreventSurroundingPre()}
+	if !r.option.ChromaCodeInlineStyle {
+		options = append(options, chromahtml.WithClasses(), chromahtml.ClassPrefix(r.option.ChromaCodeClassPrefix))
+	}
+	options = append(options, chromahtml.BaseLineNumber(info.lineNumberStart))
+	if info.lineNumbers {
+		options = append(options, chromahtml.WithLineNumbers())
+	}
+	if 0 < len(info.highlightLines) {
+		// 高亮行号是代码块中的第几行，而 chroma 按显示的行号计算，所以需要加上起始行号的偏移
+		var ranges [][2]int
+		for _, lines := range info.highlightLines {
+			ranges = append(ranges, [2]int{lines[0] + info.lineNumberStart - 1, lines[1] + info.lineNumberStart - 1})
+		}
+		options = append(options, chromahtml.HighlightLines(ranges))
+	}
+	formatter := chromahtml.New(options...)
+	var b bytes.Buffer
+	if err = formatter.Format(&b, styles.Get(r.option.ChromaCodeStyle), iterator); nil != err {
+		return false
+	}
+	r.Write(b.Bytes())
+	return true
+}
+
 func (r *Renderer) renderEmphasisHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("em", nil, false)
+		r.Tag("em", nil, false)
 	} else {
-		r.tag("/em", nil, false)
+		r.Tag("/em", nil, false)
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderStrongHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.writeString("<strong>")
-		r.write(node.Tokens())
+		r.WriteString("<strong>")
+		r.Write(node.Tokens())
 	} else {
-		r.writeString("</strong>")
+		r.WriteString("</strong>")
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderBlockquoteHTML(n Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.newline()
-		r.writeString("<blockquote>")
-		r.newline()
+		r.Newline()
+		r.Tag("blockquote", r.sourcePos(n), false)
+		r.Newline()
 	} else {
-		r.newline()
-		r.writeString("</blockquote>")
-		r.newline()
+		r.Newline()
+		r.WriteString("</blockquote>")
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
@@ -353,11 +670,21 @@ func (r *Renderer) renderBlockquoteHTML(n Node, entering bool) (WalkStatus, erro
 func (r *Renderer) renderHeadingHTML(node Node, entering bool) (WalkStatus, error) {
 	n := node.(*Heading)
 	if entering {
-		r.newline()
-		r.writeString("<h" + " 123456"[n.Level:n.Level+1] + ">")
+		r.Newline()
+		attrs := r.sourcePos(n)
+		id := fromItems(escapeHTML(n.ID))
+		if "" != id {
+			attrs = append([][]string{{"id", id}}, attrs...)
+		}
+		r.Tag("h"+" 123456"[n.Level:n.Level+1], attrs, false)
+		if "" != id && r.option.HeadingAnchor {
+			r.Tag("a", [][]string{{"class", "anchor"}, {"href", "#" + id}, {"aria-hidden", "true"}}, false)
+			r.writeByte('#')
+			r.Tag("/a", nil, false)
+		}
 	} else {
-		r.writeString("</h" + " 123456"[n.Level:n.Level+1] + ">")
-		r.newline()
+		r.WriteString("</h" + " 123456"[n.Level:n.Level+1] + ">")
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
@@ -369,28 +696,28 @@ func (r *Renderer) renderListHTML(node Node, entering bool) (WalkStatus, error)
 		tag = "ol"
 	}
 	if entering {
-		r.newline()
-		attrs := [][]string{{"start", fmt.Sprintf("%d", n.start)}}
+		r.Newline()
+		var attrs [][]string
 		if nil == n.bulletChar && 1 != n.start {
-			r.tag(tag, attrs, false)
-		} else {
-			r.tag(tag, nil, false)
+			attrs = append(attrs, []string{"start", fmt.Sprintf("%d", n.start)})
 		}
-		r.newline()
+		attrs = append(attrs, r.sourcePos(n)...)
+		r.Tag(tag, attrs, false)
+		r.Newline()
 	} else {
-		r.newline()
-		r.tag("/"+tag, nil, false)
-		r.newline()
+		r.Newline()
+		r.Tag("/"+tag, nil, false)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderListItemHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("li", nil, false)
+		r.Tag("li", r.sourcePos(node), false)
 	} else {
-		r.tag("/li", nil, false)
-		r.newline()
+		r.Tag("/li", nil, false)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
@@ -403,24 +730,24 @@ func (r *Renderer) renderTaskListItemMarkerHTML(node Node, entering bool) (WalkS
 			attrs = append(attrs, []string{"checked", ""})
 		}
 		attrs = append(attrs, []string{"disabled", ""}, []string{"type", "checkbox"})
-		r.tag("input", attrs, true)
+		r.Tag("input", attrs, true)
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderThematicBreakHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.newline()
-		r.tag("hr", nil, true)
-		r.newline()
+		r.Newline()
+		r.Tag("hr", r.sourcePos(node), true)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
 
 func (r *Renderer) renderHardBreakHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
-		r.tag("br", nil, true)
-		r.newline()
+		r.Tag("br", nil, true)
+		r.Newline()
 	}
 	return WalkContinue, nil
 }
@@ -428,29 +755,40 @@ func (r *Renderer) renderHardBreakHTML(node Node, entering bool) (WalkStatus, er
 func (r *Renderer) renderSoftBreakHTML(node Node, entering bool) (WalkStatus, error) {
 	if entering {
 		if r.option.SoftBreak2HardBreak {
-			r.tag("br", nil, true)
-			r.newline()
+			r.Tag("br", nil, true)
+			r.Newline()
 		} else {
-			r.newline()
+			r.Newline()
 		}
 	}
 	return WalkContinue, nil
 }
 
-func (r *Renderer) tag(name string, attrs [][]string, selfclosing bool) {
+// sourcePos 在启用 SourcePos 时返回节点 node 的源码位置属性 data-sourcepos，格式为“起始行:起始列-结束行:结束列”。
+func (r *Renderer) sourcePos(node Node) [][]string {
+	if !r.option.SourcePos {
+		return nil
+	}
+
+	pos := node.Position()
+	return [][]string{{"data-sourcepos", fmt.Sprintf("%d:%d-%d:%d", pos.StartLine, pos.StartColumn, pos.EndLine, pos.EndColumn)}}
+}
+
+// Tag 输出 HTML 标签，name 为标签名（结束标签以 / 开头），attrs 为属性列表，selfclosing 表示是否是自闭合标签。
+func (r *Renderer) Tag(name string, attrs [][]string, selfclosing bool) {
 	if r.disableTags > 0 {
 		return
 	}
 
-	r.writeString("<")
-	r.write(toItems(name))
+	r.WriteString("<")
+	r.Write(toItems(name))
 	if 0 < len(attrs) {
 		for _, attr := range attrs {
-			r.writeString(" " + attr[0] + "=\"" + attr[1] + "\"")
+			r.WriteString(" " + attr[0] + "=\"" + attr[1] + "\"")
 		}
 	}
 	if selfclosing {
-		r.writeString(" /")
+		r.WriteString(" /")
 	}
-	r.writeString(">")
+	r.WriteString(">")
 }
@@ -17,6 +17,8 @@ type Image struct {
 	*BaseNode
 	Destination items // 图片链接地址
 	Title       items // 图片标题
+	RefLabel    items // 引用图片使用的标签，内联图片为 nil
+	refType     int   // 引用图片的形式，内联图片为 0
 }
 
 // parseBang 解析 !，可能是图片标记开始 ![ 也可能是普通文本 !。
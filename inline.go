@@ -59,6 +59,14 @@ func (t *Tree) walkParseInline(node Node, wg *sync.WaitGroup) {
 			tokens:    tokens,
 			tokensLen: length,
 		}
+		switch n := node.(type) {
+		case *Paragraph:
+			ctx.lines = n.lines
+		case *Heading:
+			ctx.lines = n.lines
+		case *TableCell:
+			ctx.lines = n.lines
+		}
 
 		// 生成该块节点的行级子节点
 		t.parseInline(node, ctx)
@@ -66,25 +74,8 @@ func (t *Tree) walkParseInline(node Node, wg *sync.WaitGroup) {
 		// 处理该块节点中的强调、加粗和删除线
 		t.processEmphasis(nil, ctx)
 
-		// 将连续的文本节点进行合并。
-		// 规范只是定义了从输入的 Markdown 文本到输出的 HTML 的解析渲染规则，并未定义中间语法树的规则。
-		// 也就是说语法树的节点结构没有标准，可以自行发挥。这里进行文本节点合并主要有两个目的：
-		// 1. 减少节点数量，提升后续处理性能
-		// 2. 方便后续功能方面的处理，比如 GFM 自动链接解析
-		t.mergeText(node)
-
-		if t.context.option.GFMAutoLink {
-			t.parseGFMAutoEmailLink(node)
-			t.parseGFMAutoLink(node)
-		}
-
-		if t.context.option.AutoSpace {
-			t.space(node)
-		}
-
-		if t.context.option.FixTermTypo {
-			t.fixTermTypo(node)
-		}
+		// 使用变换器处理该块节点
+		t.transformBlock(node)
 		return
 	}
 
@@ -104,38 +95,66 @@ func (t *Tree) walkParseInline(node Node, wg *sync.WaitGroup) {
 // parseInline 解析并生成块节点 block 的行级子节点。
 func (t *Tree) parseInline(block Node, ctx *InlineContext) {
 	for {
+		start := ctx.pos
 		token := ctx.tokens[ctx.pos]
 		var n Node
-		switch token {
-		case itemBackslash:
-			n = t.parseBackslash(ctx)
-		case itemBacktick:
-			n = t.parseCodeSpan(ctx)
-		case itemAsterisk, itemUnderscore, itemTilde:
-			t.handleDelim(block, ctx)
-		case itemNewline:
-			n = t.parseNewline(block, ctx)
-		case itemLess:
-			n = t.parseAutolink(ctx)
-			if nil == n {
-				n = t.parseAutoEmailLink(ctx)
+		handled := false
+		if exts := t.context.option.inlineExts[token]; nil != exts {
+			n, handled = t.parseInlineExt(block, ctx, exts)
+		}
+		if !handled {
+			switch token {
+			case itemBackslash:
+				n = t.parseBackslash(ctx)
+			case itemBacktick:
+				n = t.parseCodeSpan(ctx)
+			case itemAsterisk, itemUnderscore, itemTilde:
+				t.handleDelim(block, ctx)
+			case itemNewline:
+				n = t.parseNewline(block, ctx)
+			case itemLess:
+				n = t.parseAutolink(ctx)
+				if nil == n {
+					n = t.parseAutoEmailLink(ctx)
+					if nil == n {
+						n = t.parseInlineHTML(ctx)
+					}
+				}
+			case itemOpenBracket:
+				if t.context.option.Footnotes {
+					n = t.parseFootnotesRef(ctx)
+				}
+				if nil == n {
+					n = t.parseOpenBracket(ctx)
+				}
+			case itemCloseBracket:
+				n = t.parseCloseBracket(ctx)
+			case itemAmpersand:
+				n = t.parseEntity(ctx)
+			case itemDollar:
+				if t.context.option.Math {
+					n = t.parseInlineMath(ctx)
+				} else {
+					n = t.parseText(ctx)
+				}
+			case itemColon:
+				if t.context.option.Emoji {
+					n = t.parseEmoji(block, ctx)
+				}
 				if nil == n {
-					n = t.parseInlineHTML(ctx)
+					n = t.parseText(ctx)
 				}
+			case itemBang:
+				n = t.parseBang(ctx)
+			default:
+				n = t.parseText(ctx)
 			}
-		case itemOpenBracket:
-			n = t.parseOpenBracket(ctx)
-		case itemCloseBracket:
-			n = t.parseCloseBracket(ctx)
-		case itemAmpersand:
-			n = t.parseEntity(ctx)
-		case itemBang:
-			n = t.parseBang(ctx)
-		default:
-			n = t.parseText(ctx)
 		}
 
 		if nil != n {
+			if 0 == n.Position().StartLine {
+				ctx.setPos(n, start, ctx.pos)
+			}
 			block.AppendChild(block, n)
 		}
 
@@ -268,6 +287,7 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) Node {
 	}
 
 	var reflabel string
+	var refType int
 	if !matched {
 		// 尝试解析链接 label
 		var beforelabel = ctx.pos + 1
@@ -275,13 +295,16 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) Node {
 		var n = len(passed)
 		if n > 0 { // label 解析出来的话说明满足格式 [text][label]
 			reflabel = label
+			refType = linkRefFull
 			ctx.pos += n + 1
 		} else if !opener.bracketAfter {
 			// [text][] 或者 [text][] 格式，将第一个 text 视为 label 进行解析
 			passed = ctx.tokens[opener.index:startPos]
 			reflabel = fromItems(passed)
+			refType = linkRefShortcut
 			if len(passed) > 0 && ctx.tokensLen > beforelabel && itemOpenBracket == ctx.tokens[beforelabel] {
 				// [text][] 格式，跳过 []
+				refType = linkRefCollapsed
 				ctx.pos += 2
 			}
 		}
@@ -300,9 +323,22 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) Node {
 	if matched {
 		var node Node
 		if isImage {
-			node = &Image{&BaseNode{typ: NodeImage}, dest, title}
+			node = &Image{&BaseNode{typ: NodeImage}, dest, title, nil, 0}
 		} else {
-			node = &Link{&BaseNode{typ: NodeLink}, dest, title}
+			node = &Link{&BaseNode{typ: NodeLink}, dest, title, nil, 0}
+		}
+		if "" != reflabel {
+			// 记录引用链接的标签和形式，格式化时保持引用链接的写法
+			switch n := node.(type) {
+			case *Image:
+				n.RefLabel, n.refType = items(reflabel), refType
+			case *Link:
+				n.RefLabel, n.refType = items(reflabel), refType
+			}
+		}
+		openerStart := opener.index - 1 // 跳过 [
+		if isImage {
+			openerStart-- // 跳过 !
 		}
 
 		var tmp, next Node
@@ -332,6 +368,7 @@ func (t *Tree) parseCloseBracket(ctx *InlineContext) Node {
 		}
 
 		ctx.pos++
+		ctx.setPos(node, openerStart, ctx.pos)
 		return node
 	} else { // no match
 		t.removeBracket(ctx) // remove this opener from stack
@@ -389,7 +426,8 @@ func (t *Tree) parseBackslash(ctx *InlineContext) (ret Node) {
 func (t *Tree) parseText(ctx *InlineContext) (ret Node) {
 	var token byte
 	start := ctx.pos
-	for ; ctx.pos < ctx.tokensLen; ctx.pos++ {
+	// 第一个字符直接作为文本，因为它可能是扩展没有匹配上的触发字符
+	for ctx.pos++; ctx.pos < ctx.tokensLen; ctx.pos++ {
 		token = ctx.tokens[ctx.pos]
 		if t.isMarker(token) {
 			// 遇到潜在的标记符时需要跳出 text，回到行级解析主循环
@@ -405,7 +443,8 @@ func (t *Tree) parseText(ctx *InlineContext) (ret Node) {
 func (t *Tree) isMarker(token byte) bool {
 	return itemAsterisk == token || itemUnderscore == token || itemOpenBracket == token || itemBang == token ||
 		itemNewline == token || itemBackslash == token || itemBacktick == token ||
-		itemLess == token || itemCloseBracket == token || itemAmpersand == token || itemTilde == token
+		itemLess == token || itemCloseBracket == token || itemAmpersand == token || itemTilde == token ||
+		(itemDollar == token && t.context.option.Math) || (itemColon == token && t.context.option.Emoji) || nil != t.context.option.inlineExts[token]
 }
 
 func (t *Tree) parseNewline(block Node, ctx *InlineContext) (ret Node) {
@@ -418,6 +457,9 @@ func (t *Tree) parseNewline(block Node, ctx *InlineContext) (ret Node) {
 			tokens := text.tokens
 			if valueLen := len(tokens); itemSpace == tokens[valueLen-1] {
 				lastc.SetTokens(bytes.TrimRight(tokens, " \t\n"))
+				trimmed := valueLen - len(lastc.Tokens())
+				lastc.Position().EndColumn -= trimmed
+				lastc.Position().EndOffset -= trimmed
 				if 1 < valueLen {
 					hardbreak = itemSpace == tokens[len(tokens)-2]
 				}
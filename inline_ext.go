@@ -0,0 +1,74 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+// InlineParseFunc 描述了行级扩展解析函数签名。解析时 ctx 的当前位置就是触发字符，解析成功需要通过 ctx.SetPos 将位置移动到
+// 解析内容之后并返回生成的节点；返回 nil 表示不匹配，此时会继续尝试其他扩展以及内置解析。
+type InlineParseFunc func(t *Tree, ctx *InlineContext) Node
+
+// InlineDelimFunc 描述了行级扩展分隔符节点构造函数签名。num 为本次配对使用的分隔符个数（1 或者 2），
+// 返回的节点将包含开始和结束分隔符之间的所有节点；返回 nil 表示不处理这次配对。
+type InlineDelimFunc func(num int) Node
+
+// InlineExt 描述了行级语法扩展。
+type InlineExt struct {
+	Trigger byte            // 触发字符
+	Parse   InlineParseFunc // 解析函数，可以为 nil
+	Delim   InlineDelimFunc // 分隔符节点构造函数，不为 nil 时触发字符将像 *、_ 和 ~ 一样作为分隔符参与强调处理
+}
+
+// parseInlineExt 使用触发字符对应的扩展 exts 进行解析，handled 返回 false 表示没有扩展能够处理，需要继续使用内置解析。
+func (t *Tree) parseInlineExt(block Node, ctx *InlineContext, exts []*InlineExt) (ret Node, handled bool) {
+	start := ctx.pos
+	for _, ext := range exts {
+		if nil != ext.Parse {
+			if ret = ext.Parse(t, ctx); nil != ret {
+				return ret, true
+			}
+			ctx.pos = start
+		}
+	}
+
+	for _, ext := range exts {
+		if nil != ext.Delim {
+			t.handleDelim(block, ctx)
+			return nil, true
+		}
+	}
+	return nil, false
+}
+
+// inlineDelimExt 返回分隔符 token 对应的扩展，没有的话返回 nil。
+func (t *Tree) inlineDelimExt(token byte) *InlineExt {
+	for _, ext := range t.context.option.inlineExts[token] {
+		if nil != ext.Delim {
+			return ext
+		}
+	}
+	return nil
+}
+
+// Tokens 返回当前解析的所有 tokens。
+func (ctx *InlineContext) Tokens() []byte {
+	return ctx.tokens
+}
+
+// Pos 返回当前解析到的位置。
+func (ctx *InlineContext) Pos() int {
+	return ctx.pos
+}
+
+// SetPos 设置当前解析到的位置。
+func (ctx *InlineContext) SetPos(pos int) {
+	ctx.pos = pos
+}
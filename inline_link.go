@@ -22,6 +22,8 @@ type Link struct {
 	*BaseNode
 	Destination items
 	Title       items
+	RefLabel    items // 引用链接使用的标签或者链接引用定义的标签，内联链接为 nil
+	refType     int   // 引用链接的形式，内联链接为 0
 }
 
 func (context *Context) parseInlineLink(tokens items) (passed, remains, destination items) {
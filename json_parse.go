@@ -0,0 +1,200 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// parseJSON 会将 JSON 渲染器输出的 JSON 字符数组还原为一颗语法树。
+func parseJSON(name string, data []byte, option options) (tree *Tree, err error) {
+	root := &jsonNode{}
+	if err = json.Unmarshal(data, root); nil != err {
+		return
+	}
+
+	types := nodeTypes()
+	var node Node
+	if node, err = newNodeFromJSON(root, types); nil != err {
+		return
+	}
+	doc, ok := node.(*Document)
+	if !ok {
+		return nil, errors.New("root node type must be [NodeDocument], got [" + root.Type + "]")
+	}
+
+	tree = &Tree{Name: name, Root: doc, context: &Context{option: option}}
+	tree.context.tree = tree
+	return
+}
+
+// newNodeFromJSON 使用 jsonNode 构造节点，子节点会递归构造。
+func newNodeFromJSON(data *jsonNode, types map[string]int) (ret Node, err error) {
+	typ, ok := types[data.Type]
+	if !ok {
+		return nil, errors.New("unknown node type [" + data.Type + "]")
+	}
+
+	base := &BaseNode{typ: typ, close: true}
+	if nil != data.Position {
+		base.pos = *data.Position
+	}
+	if "" != data.Tokens {
+		base.tokens = items(data.Tokens)
+	}
+
+	switch typ {
+	case NodeDocument:
+		doc := &Document{base, nil}
+		for _, defData := range data.LinkRefDefs {
+			var def Node
+			if def, err = newNodeFromJSON(defData, types); nil != err {
+				return
+			}
+			link, ok := def.(*Link)
+			if !ok {
+				return nil, errors.New("link reference definition node type must be [NodeLink], got [" + defData.Type + "]")
+			}
+			doc.LinkRefDefs = append(doc.LinkRefDefs, link)
+		}
+		ret = doc
+	case NodeParagraph:
+		ret = &Paragraph{base}
+	case NodeHeading:
+		ret = &Heading{base, data.Level, jsonItems(data.ID), jsonItems(data.CustomID)}
+	case NodeThematicBreak:
+		ret = &ThematicBreak{base}
+	case NodeBlockquote:
+		ret = &Blockquote{base}
+	case NodeList:
+		ret = &List{base, newListDataFromJSON(data.ListData)}
+	case NodeListItem:
+		ret = &ListItem{base, newListDataFromJSON(data.ListData)}
+	case NodeHTMLBlock:
+		ret = &HTMLBlock{base, data.HTMLType}
+	case NodeInlineHTML:
+		ret = &InlineHTML{base}
+	case NodeCodeBlock:
+		codeBlock := &CodeBlock{BaseNode: base, isFenced: data.Fenced, fenceLength: data.FenceLength, fenceOffset: data.FenceOffset}
+		if "" != data.FenceChar {
+			codeBlock.fenceChar = data.FenceChar[0]
+		}
+		if "" != data.Info {
+			codeBlock.info = items(data.Info)
+		}
+		ret = codeBlock
+	case NodeText:
+		ret = &Text{tokens: base.tokens, close: true, pos: base.pos}
+	case NodeEmphasis:
+		ret = &Emphasis{base}
+	case NodeStrong:
+		ret = &Strong{base}
+	case NodeCodeSpan:
+		ret = &CodeSpan{base}
+	case NodeHardBreak:
+		ret = &HardBreak{base}
+	case NodeSoftBreak:
+		ret = &SoftBreak{base}
+	case NodeLink:
+		ret = &Link{base, jsonItems(data.Destination), titleFromJSON(data.Title), jsonItems(data.RefLabel), data.RefType}
+	case NodeImage:
+		ret = &Image{base, jsonItems(data.Destination), titleFromJSON(data.Title), jsonItems(data.RefLabel), data.RefType}
+	case NodeTaskListItemMarker:
+		ret = &TaskListItemMarker{base, data.Checked}
+	case NodeStrikethrough:
+		ret = &Strikethrough{base}
+	case NodeTable:
+		ret = &Table{base, data.Aligns}
+	case NodeTableHead:
+		ret = &TableHead{base}
+	case NodeTableRow:
+		ret = &TableRow{base, data.Aligns}
+	case NodeTableCell:
+		ret = &TableCell{base, data.Align}
+	case NodeFootnotesDef:
+		ret = &FootnotesDef{base, jsonItems(data.Label)}
+	case NodeFootnotesRef:
+		ret = &FootnotesRef{base, jsonItems(data.Label)}
+	case NodeMathBlock:
+		ret = &MathBlock{base}
+	case NodeInlineMath:
+		ret = &InlineMath{base}
+	case NodeFrontMatter:
+		frontMatter := &FrontMatterBlock{base, itemHyphen}
+		if "" != data.FenceChar {
+			frontMatter.delimiter = data.FenceChar[0]
+		}
+		ret = frontMatter
+	case NodeTOC:
+		ret = &TableOfContents{base}
+	case NodeEmoji:
+		ret = &EmojiShortcode{base}
+	case NodeMention:
+		ret = &Mention{base}
+	case NodeHashtag:
+		ret = &Hashtag{base}
+	default:
+		if factory := nodeTypeFactory(typ); nil != factory {
+			ret = factory(base)
+		} else {
+			ret = base
+		}
+	}
+
+	for _, child := range data.Children {
+		var c Node
+		if c, err = newNodeFromJSON(child, types); nil != err {
+			return
+		}
+		ret.AppendChild(ret, c)
+	}
+	return
+}
+
+func newListDataFromJSON(data *jsonListData) (ret *listData) {
+	ret = &listData{}
+	if nil == data {
+		return
+	}
+
+	ret.typ = data.Typ
+	ret.tight = data.Tight
+	ret.bulletChar = jsonItems(data.BulletChar)
+	ret.start = data.Start
+	if "" != data.Delimiter {
+		ret.delimiter = data.Delimiter[0]
+	}
+	ret.padding = data.Padding
+	ret.markerOffset = data.MarkerOffset
+	ret.checked = data.Checked
+	ret.marker = jsonItems(data.Marker)
+	ret.num = data.Num
+	return
+}
+
+// titleFromJSON 将 JSON 中的链接、图片标题转换为 items，null 转换为 nil，空串转换为空的 items。
+func titleFromJSON(title *string) items {
+	if nil == title {
+		return nil
+	}
+	return items(*title)
+}
+
+// jsonItems 将 JSON 中的字符串属性转换为 items，空串转换为 nil。
+func jsonItems(str string) items {
+	if "" == str {
+		return nil
+	}
+	return items(str)
+}
@@ -0,0 +1,207 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"encoding/json"
+)
+
+// nodeTypeNames 定义了节点类型到 JSON 中节点类型名称的映射。扩展节点类型会在运行时注册，所以需要通过 nodeTypeLock 访问。
+var nodeTypeNames = map[int]string{
+	NodeDocument:      "NodeDocument",
+	NodeParagraph:     "NodeParagraph",
+	NodeHeading:       "NodeHeading",
+	NodeThematicBreak: "NodeThematicBreak",
+	NodeBlockquote:    "NodeBlockquote",
+	NodeList:          "NodeList",
+	NodeListItem:      "NodeListItem",
+	NodeHTMLBlock:     "NodeHTMLBlock",
+	NodeInlineHTML:    "NodeInlineHTML",
+	NodeCodeBlock:     "NodeCodeBlock",
+	NodeText:          "NodeText",
+	NodeEmphasis:      "NodeEmphasis",
+	NodeStrong:        "NodeStrong",
+	NodeCodeSpan:      "NodeCodeSpan",
+	NodeHardBreak:     "NodeHardBreak",
+	NodeSoftBreak:     "NodeSoftBreak",
+	NodeLink:          "NodeLink",
+	NodeImage:         "NodeImage",
+
+	NodeTaskListItemMarker: "NodeTaskListItemMarker",
+	NodeStrikethrough:      "NodeStrikethrough",
+	NodeTable:              "NodeTable",
+	NodeTableHead:          "NodeTableHead",
+	NodeTableRow:           "NodeTableRow",
+	NodeTableCell:          "NodeTableCell",
+
+	NodeFootnotesDef: "NodeFootnotesDef",
+	NodeFootnotesRef: "NodeFootnotesRef",
+	NodeMathBlock:    "NodeMathBlock",
+	NodeInlineMath:   "NodeInlineMath",
+	NodeTOC:          "NodeTOC",
+	NodeFrontMatter:  "NodeFrontMatter",
+	NodeEmoji:        "NodeEmoji",
+	NodeMention:      "NodeMention",
+	NodeHashtag:      "NodeHashtag",
+}
+
+// jsonNode 描述了节点在 JSON 中的结构。
+type jsonNode struct {
+	Type        string        `json:"type"`                  // 节点类型名称
+	Tokens      string        `json:"tokens,omitempty"`      // 叶子节点内容
+	Position    *Position     `json:"position,omitempty"`    // 在原文中的位置
+	Level       int           `json:"level,omitempty"`       // 标题级别
+	ID          string        `json:"id,omitempty"`          // 标题 id
+	CustomID    string        `json:"customId,omitempty"`    // 标题自定义 id
+	Destination string        `json:"destination,omitempty"` // 链接、图片地址
+	Title       *string       `json:"title,omitempty"`       // 链接、图片标题，没有标题时为 null 以区分空标题 ""
+	RefLabel    string        `json:"refLabel,omitempty"`    // 引用链接、图片以及链接引用定义的标签
+	RefType     int           `json:"refType,omitempty"`     // 引用链接、图片的形式
+	Aligns      []int         `json:"aligns,omitempty"`      // 表、表行每列的对齐方式
+	Align       int           `json:"align,omitempty"`       // 表格对齐方式
+	ListData    *jsonListData `json:"listData,omitempty"`    // 列表、列表项附加信息
+	Fenced      bool          `json:"fenced,omitempty"`      // 是否是围栏代码块
+	FenceChar   string        `json:"fenceChar,omitempty"`   // 围栏代码块围栏字符
+	FenceLength int           `json:"fenceLength,omitempty"` // 围栏代码块围栏长度
+	FenceOffset int           `json:"fenceOffset,omitempty"` // 围栏代码块围栏缩进
+	Info        string        `json:"info,omitempty"`        // 围栏代码块信息
+	Checked     bool          `json:"checked,omitempty"`     // 任务列表项是否勾选
+	HTMLType    int           `json:"htmlType,omitempty"`    // HTML 块类型
+	Label       string        `json:"label,omitempty"`       // 脚注标签
+	LinkRefDefs []*jsonNode   `json:"linkRefDefs,omitempty"` // 文档中的链接引用定义
+	Children    []*jsonNode   `json:"children,omitempty"`    // 子节点
+}
+
+// jsonListData 描述了列表附加信息 listData 在 JSON 中的结构。
+type jsonListData struct {
+	Typ          int    `json:"typ"`
+	Tight        bool   `json:"tight,omitempty"`
+	BulletChar   string `json:"bulletChar,omitempty"`
+	Start        int    `json:"start,omitempty"`
+	Delimiter    string `json:"delimiter,omitempty"`
+	Padding      int    `json:"padding,omitempty"`
+	MarkerOffset int    `json:"markerOffset,omitempty"`
+	Checked      bool   `json:"checked,omitempty"`
+	Marker       string `json:"marker,omitempty"`
+	Num          int    `json:"num,omitempty"`
+}
+
+// newJSONRenderer 创建一个 JSON 渲染器。
+func newJSONRenderer(option options) (ret *Renderer) {
+	ret = &Renderer{rendererFuncs: map[int]RendererFunc{}, extRendererFuncs: option.jsonRendererFuncs, option: option}
+
+	// 所有节点都使用同样的渲染函数
+
+	for _, typ := range nodeTypes() {
+		ret.rendererFuncs[typ] = ret.renderNodeJSON
+	}
+
+	return
+}
+
+// renderNodeJSON 渲染节点 node。节点属性通过 jsonNode 编码输出，子节点在遍历过程中依次输出到 children 数组中。
+func (r *Renderer) renderNodeJSON(node Node, entering bool) (WalkStatus, error) {
+	if !entering {
+		r.WriteString("]}")
+		return WalkContinue, nil
+	}
+
+	if nil != node.Previous() && nil != node.Parent() {
+		r.writeByte(',')
+	}
+
+	data, err := json.Marshal(newJSONNode(node))
+	if nil != err {
+		return WalkStop, err
+	}
+	r.Write(data[:len(data)-1]) // 去掉结尾的 }
+	r.WriteString(",\"children\":[")
+	return WalkContinue, nil
+}
+
+// newJSONNode 使用节点 node 的属性（不包括子节点）构造 jsonNode。
+func newJSONNode(node Node) (ret *jsonNode) {
+	ret = &jsonNode{Type: nodeTypeName(node.Type())}
+	if nil == node.FirstChild() {
+		ret.Tokens = fromItems(node.Tokens())
+	}
+	if pos := node.Position(); 0 < pos.StartLine {
+		ret.Position = pos
+	}
+
+	switch n := node.(type) {
+	case *Document:
+		for _, def := range n.LinkRefDefs {
+			ret.LinkRefDefs = append(ret.LinkRefDefs, newJSONNode(def))
+		}
+	case *Heading:
+		ret.Level, ret.ID, ret.CustomID = n.Level, fromItems(n.ID), fromItems(n.CustomID)
+	case *Link:
+		ret.Destination, ret.Title, ret.RefLabel, ret.RefType = fromItems(n.Destination), jsonTitle(n.Title), fromItems(n.RefLabel), n.refType
+	case *Image:
+		ret.Destination, ret.Title, ret.RefLabel, ret.RefType = fromItems(n.Destination), jsonTitle(n.Title), fromItems(n.RefLabel), n.refType
+	case *Table:
+		ret.Aligns = n.Aligns
+	case *TableRow:
+		ret.Aligns = n.Aligns
+	case *TableCell:
+		ret.Align = n.Aligns
+	case *List:
+		ret.ListData = newJSONListData(n.listData)
+	case *ListItem:
+		ret.ListData = newJSONListData(n.listData)
+	case *CodeBlock:
+		ret.Fenced, ret.FenceLength, ret.FenceOffset, ret.Info = n.isFenced, n.fenceLength, n.fenceOffset, fromItems(n.info)
+		if 0 != n.fenceChar {
+			ret.FenceChar = string(n.fenceChar)
+		}
+	case *TaskListItemMarker:
+		ret.Checked = n.checked
+	case *HTMLBlock:
+		ret.HTMLType = n.hType
+	case *FrontMatterBlock:
+		ret.FenceChar = string(n.delimiter)
+	case *FootnotesDef:
+		ret.Label = fromItems(n.Label)
+	case *FootnotesRef:
+		ret.Label = fromItems(n.Label)
+	}
+	return
+}
+
+// jsonTitle 将链接、图片标题 title 转换为 JSON 中的标题，nil 表示没有标题。
+func jsonTitle(title items) *string {
+	if nil == title {
+		return nil
+	}
+	ret := fromItems(title)
+	return &ret
+}
+
+func newJSONListData(data *listData) (ret *jsonListData) {
+	ret = &jsonListData{
+		Typ:          data.typ,
+		Tight:        data.tight,
+		BulletChar:   fromItems(data.bulletChar),
+		Start:        data.start,
+		Padding:      data.padding,
+		MarkerOffset: data.markerOffset,
+		Checked:      data.checked,
+		Marker:       fromItems(data.marker),
+		Num:          data.num,
+	}
+	if 0 != data.delimiter {
+		ret.Delimiter = string(data.delimiter)
+	}
+	return
+}
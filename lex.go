@@ -20,11 +20,13 @@ import (
 
 // lexer 描述了词法分析器结构。
 type lexer struct {
-	input   items // 输入的文本字符数组
-	length  int   // 输入的文本字符数组的长度
-	offset  int   // 当前读取位置
-	lineNum int   // 当前行号
-	width   int   // 最新一个 token 的宽度（字节数）
+	input      items // 输入的文本字符数组
+	length     int   // 输入的文本字符数组的长度
+	offset     int   // 当前读取位置
+	lineNum    int   // 当前行号
+	lineOffset int   // 当前行起始位置在原文中的字节偏移
+	shift      int   // 预处理（移除 \r、替换 \u0000）导致的读取位置和原文字节偏移之间的差值
+	width      int   // 最新一个 token 的宽度（字节数）
 }
 
 // nextLine 返回下一行。
@@ -33,6 +35,8 @@ func (l *lexer) nextLine() (line items) {
 		return
 	}
 
+	l.lineOffset = l.offset + l.shift
+
 	var b, nb byte
 	i := l.offset
 	for ; i < l.length; i += l.width {
@@ -47,6 +51,7 @@ func (l *lexer) nextLine() (line items) {
 				if itemNewline == nb {
 					l.input = append(l.input[:i], l.input[i+1:]...) // 移除 \r，依靠下一个的 \n 切行
 					l.length--                                      // 重新计算总长
+					l.shift++
 				}
 			}
 			i++
@@ -61,6 +66,7 @@ func (l *lexer) nextLine() (line items) {
 			l.input[i+1] = '\xBF'
 			l.input[i+2] = '\xBD'
 			l.length += 2 // 重新计算总长
+			l.shift -= 2
 			l.width = 3
 			continue
 		}
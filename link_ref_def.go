@@ -13,10 +13,19 @@
 package lute
 
 import (
+	"bytes"
+	"sort"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 )
 
+const (
+	linkRefFull      = iota + 1 // 完整形式的引用链接 [text][label]
+	linkRefCollapsed            // 折叠形式的引用链接 [text][]
+	linkRefShortcut             // 简写形式的引用链接 [text]
+)
+
 func (context *Context) parseLinkRefDef(tokens items) items {
 	_, tokens = tokens.trimLeft()
 	if 1 > len(tokens) {
@@ -72,11 +81,12 @@ func (context *Context) parseLinkRefDef(tokens items) items {
 		remains = tokens
 	}
 
-	link := &Link{&BaseNode{typ: NodeLink}, destination, nil}
+	link := &Link{&BaseNode{typ: NodeLink}, destination, nil, items(label), 0}
 	lowerCaseLabel := strings.ToLower(label)
 	link.Title = title
 	if _, ok := context.linkRefDef[lowerCaseLabel]; !ok {
 		context.linkRefDef[lowerCaseLabel] = link
+		context.tree.Root.LinkRefDefs = append(context.tree.Root.LinkRefDefs, link)
 	}
 
 	return remains
@@ -302,3 +312,102 @@ func (context *Context) parseLinkLabel(tokens items) (passed, remains items, lab
 
 	return
 }
+
+// linkRefs 描述了格式化时使用的链接引用信息。
+type linkRefs struct {
+	defs   []*Link          // 需要输出的链接引用定义
+	labels map[string]*Link // 已经定义的标签对应的定义，键为小写标签
+	nums   map[*Link]string // 内联链接转换为引用链接时使用的编号标签
+}
+
+// matchDef 判断标签 label 是否已经定义并且定义的地址和标题分别是 destination 和 title。
+func (refs *linkRefs) matchDef(label, destination, title items) bool {
+	def := refs.labels[strings.ToLower(fromItems(label))]
+	return nil != def && bytes.Equal(def.Destination, destination) && bytes.Equal(def.Title, title)
+}
+
+// newLinkRefs 收集文档 doc 中的链接引用定义，sorted 为 true 时按照标签字母顺序排列。
+// inlineToRefs 为 true 时按照出现顺序为内联链接编号，地址和标题都相同的链接使用同一个编号，编号会避开已经定义的标签以及
+// 文本中的 [n]，避免文本中的 [n] 被解析为引用链接。
+func newLinkRefs(doc *Document, sorted, inlineToRefs bool) (ret *linkRefs) {
+	ret = &linkRefs{labels: map[string]*Link{}, nums: map[*Link]string{}}
+	for _, def := range doc.LinkRefDefs {
+		ret.defs = append(ret.defs, def)
+		ret.labels[strings.ToLower(fromItems(def.RefLabel))] = def
+	}
+	if sorted {
+		sort.SliceStable(ret.defs, func(i, j int) bool {
+			return strings.ToLower(fromItems(ret.defs[i].RefLabel)) < strings.ToLower(fromItems(ret.defs[j].RefLabel))
+		})
+	}
+	if !inlineToRefs {
+		return
+	}
+
+	literals := bracketedNumbers(doc)
+	num := 0
+	numbered := map[string]string{}
+	Walk(doc, func(n Node, entering bool) (WalkStatus, error) {
+		link, ok := n.(*Link)
+		if !entering || !ok || 0 != link.refType || isAutoLinkText(link) {
+			return WalkContinue, nil
+		}
+
+		key := fromItems(link.Destination) + "\n" + fromItems(link.Title)
+		label, ok := numbered[key]
+		if !ok {
+			for label = ""; "" == label || nil != ret.labels[label] || literals[label]; {
+				num++
+				label = strconv.Itoa(num)
+			}
+			numbered[key] = label
+			def := &Link{&BaseNode{typ: NodeLink}, link.Destination, link.Title, items(label), 0}
+			ret.labels[label] = def
+			ret.defs = append(ret.defs, def)
+		}
+		ret.nums[link] = label
+		return WalkSkipChildren, nil
+	})
+	return
+}
+
+// bracketedNumbers 返回文档 doc 的文本中以 [n] 形式出现的数字 n。
+func bracketedNumbers(doc *Document) (ret map[string]bool) {
+	ret = map[string]bool{}
+	var text items
+	Walk(doc, func(n Node, entering bool) (WalkStatus, error) {
+		if entering && NodeText == n.Type() {
+			text = append(text, n.Tokens()...)
+		} else if entering {
+			// 文本节点之间隔开，避免跨节点拼出 [n]
+			text = append(text, itemNewline)
+		}
+		return WalkContinue, nil
+	})
+
+	for i := bytes.IndexByte(text, itemOpenBracket); 0 <= i; i = bytes.IndexByte(text, itemOpenBracket) {
+		text = text[i+1:]
+		end := 0
+		for end < len(text) && isDigit(text[end]) {
+			end++
+		}
+		if 0 < end && end < len(text) && itemCloseBracket == text[end] {
+			ret[fromItems(text[:end])] = true
+		}
+	}
+	return
+}
+
+// isAutoLinkText 判断链接 link 的文本是否就是链接地址，比如 <https://b3log.org> 和 GFM 自动链接，这样的链接不需要转换为引用链接。
+func isAutoLinkText(link *Link) bool {
+	text, ok := link.FirstChild().(*Text)
+	if !ok || nil != text.Next() {
+		return false
+	}
+	for _, prefix := range []string{"", "mailto:", "http://"} {
+		if bytes.Equal(encodeDestination(append(items(prefix), text.tokens...)), link.Destination) {
+			return true
+		}
+	}
+	return false
+}
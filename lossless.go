@@ -0,0 +1,320 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// lossless 描述了无损模式下解析时保存的原文以及各节点的快照。渲染时通过快照判断节点是否被修改过，没有修改过的节点直接输出原文。
+type lossless struct {
+	source    items                  // 原文
+	newline   string                 // 原文使用的换行符，\n 或者 \r\n，重新格式化的内容也使用该换行符
+	snapshots map[Node]*nodeSnapshot // 节点快照
+}
+
+// nodeSnapshot 描述了解析完成时节点的属性以及子节点。
+type nodeSnapshot struct {
+	attrs    []byte // 节点属性（不包括子节点）的 JSON 编码
+	children []Node // 子节点
+}
+
+// newLossless 保存原文 source 并为以 root 为根的树中的所有节点生成快照。
+func newLossless(source items, root Node) (ret *lossless) {
+	ret = &lossless{source: append(items{}, source...), newline: "\n", snapshots: map[Node]*nodeSnapshot{}}
+	if i := bytes.IndexByte(source, itemNewline); 0 < i && itemCarriageReturn == source[i-1] {
+		ret.newline = "\r\n"
+	}
+
+	Walk(root, func(n Node, entering bool) (WalkStatus, error) {
+		if entering {
+			snapshot := &nodeSnapshot{attrs: nodeAttrs(n)}
+			for child := n.FirstChild(); nil != child; child = child.Next() {
+				snapshot.children = append(snapshot.children, child)
+			}
+			ret.snapshots[n] = snapshot
+		}
+		return WalkContinue, nil
+	})
+	return
+}
+
+// nodeAttrs 返回节点 n 的属性（不包括子节点）的 JSON 编码，用于判断节点属性是否被修改过。
+func nodeAttrs(n Node) []byte {
+	data, _ := json.Marshal(newJSONNode(n))
+	return data
+}
+
+// original 判断节点 n 是否是解析得到的并且有位置信息，只有这样的节点才能通过位置信息找到原文。
+func (l *lossless) original(n Node) bool {
+	return nil != l.snapshots[n] && 0 < n.Position().StartLine
+}
+
+// attrsChanged 判断节点 n 的属性和快照相比是否被修改过，没有快照的节点（解析后通过程序插入的节点）也视为修改过。
+func (l *lossless) attrsChanged(n Node) bool {
+	snapshot := l.snapshots[n]
+	return nil == snapshot || !bytes.Equal(snapshot.attrs, nodeAttrs(n))
+}
+
+// childrenChanged 判断节点 n 的子节点列表和快照相比是否被修改过。
+func (l *lossless) childrenChanged(n Node) bool {
+	snapshot := l.snapshots[n]
+	if nil == snapshot {
+		return true
+	}
+	i := 0
+	for child := n.FirstChild(); nil != child; child = child.Next() {
+		if i >= len(snapshot.children) || snapshot.children[i] != child {
+			return true
+		}
+		i++
+	}
+	return i != len(snapshot.children)
+}
+
+// modified 返回以 root 为根的树中被修改过的节点，节点的属性、子节点列表或者任意后代节点被修改过都视为该节点被修改过。
+func (l *lossless) modified(root Node) (ret map[Node]bool) {
+	ret = map[Node]bool{}
+	Walk(root, func(n Node, entering bool) (WalkStatus, error) {
+		if entering {
+			return WalkContinue, nil
+		}
+
+		modified := l.attrsChanged(n) || l.childrenChanged(n)
+		for child := n.FirstChild(); nil != child && !modified; child = child.Next() {
+			modified = ret[child]
+		}
+		if modified {
+			ret[n] = true
+		}
+		return WalkContinue, nil
+	})
+	return
+}
+
+// renderLossless 无损渲染以 root 为根的树：没有修改过的子树直接输出原文，修改过的子树重新格式化。
+func (r *Renderer) renderLossless(root Node) error {
+	if doc, ok := root.(*Document); ok {
+		// 链接引用定义保留在原文中，这里只用于判断重新格式化的引用链接的标签是否已经定义
+		r.linkRefs = newLinkRefs(doc, false, false)
+	}
+	return r.renderLosslessNode(root, r.lossless.modified(root))
+}
+
+// renderLosslessNode 无损渲染节点 n。如果只是子节点被修改过，则原样输出子节点之间的原文（比如列表标识符、块引用的 >、强调分隔符以及空行等），
+// 再递归渲染子节点；否则重新格式化节点 n。
+func (r *Renderer) renderLosslessNode(n Node, modified map[Node]bool) error {
+	l := r.lossless
+	pos := n.Position()
+	start, end := pos.StartOffset, pos.EndOffset
+	block := NodeDocument == n.Type()
+	if block {
+		start, end = 0, len(l.source)
+	}
+	if !modified[n] {
+		if block || 0 < pos.StartLine {
+			r.Write(l.source[start:end])
+		}
+		// 没有位置信息的节点（比如空的单元格）包含在兄弟节点之间的原文中
+		return nil
+	}
+
+	if (!block && !l.original(n)) || l.attrsChanged(n) || !l.reusable(n, start, end) {
+		return r.renderReformatted(n)
+	}
+
+	cursor, positioned := start, false
+	var inserted []Node // 上一个原有子节点之后插入的节点
+	for child := n.FirstChild(); nil != child; child = child.Next() {
+		if !l.original(child) {
+			if nil == l.snapshots[child] {
+				inserted = append(inserted, child)
+			}
+			continue
+		}
+
+		childPos := child.Position()
+		if positioned {
+			if err := r.renderInserted(inserted, block, true); nil != err {
+				return err
+			}
+			r.Write(l.gap(n, cursor, childPos.StartOffset, false))
+		} else {
+			// 第一个原有子节点之前插入的节点在该子节点之前的原文（比如前导空行）之后输出
+			r.Write(l.gap(n, cursor, childPos.StartOffset, false))
+			if err := r.renderInserted(inserted, block, false); nil != err {
+				return err
+			}
+		}
+		inserted = nil
+		if err := r.renderLosslessNode(child, modified); nil != err {
+			return err
+		}
+		cursor, positioned = childPos.EndOffset, true
+	}
+
+	tail := l.gap(n, cursor, end, true)
+	if block && 0 < len(inserted) {
+		// 追加到文档末尾的节点需要在末尾的原文（比如链接引用定义）之后输出
+		r.Write(bytes.TrimRight(tail, "\r\n"))
+		if err := r.renderInserted(inserted, block, true); nil != err {
+			return err
+		}
+		r.WriteString(l.newline)
+		return nil
+	}
+	if err := r.renderInserted(inserted, block, true); nil != err {
+		return err
+	}
+	r.Write(tail)
+	return nil
+}
+
+// gap 返回节点 n 的原文 [from, to) 中去掉已经被移除的子节点后的部分。被移除的子节点连同其后的空白一起去掉，块节点只去掉
+// 其后的空行，如果位于节点 n 的末尾（tail 为 true）则去掉其前面的空白，但保留原文末尾的换行。
+func (l *lossless) gap(n Node, from, to int, tail bool) (ret items) {
+	snapshot := l.snapshots[n]
+	cursor := from
+	for _, child := range snapshot.children {
+		pos := child.Position()
+		if n == child.Parent() || 1 > pos.StartLine || pos.StartOffset < cursor || pos.EndOffset > to {
+			continue
+		}
+
+		// 块节点后面只跳过整行的空白，保留下一个节点所在行的缩进
+		start, end := pos.StartOffset, pos.EndOffset
+		i := end
+		for ; i < to && isWhitespace(l.source[i]); i++ {
+			if itemNewline == l.source[i] {
+				end = i + 1
+			}
+		}
+		if (tail && i == to) || !isBlockNode(child) {
+			end = i
+		}
+		if tail && end == to {
+			for start > cursor && isWhitespace(l.source[start-1]) {
+				start--
+			}
+		}
+		ret = append(ret, l.source[cursor:start]...)
+		cursor = end
+	}
+	ret = append(ret, l.source[cursor:to]...)
+	if tail && cursor == to && from < to && itemNewline == l.source[to-1] && (0 == len(ret) || itemNewline != ret[len(ret)-1]) {
+		ret = append(ret, l.newline...)
+	}
+	return
+}
+
+// renderInserted 重新格式化插入的节点 nodes。块节点之间使用空行分隔，after 为 true 时空行在节点之前，否则在节点之后。
+func (r *Renderer) renderInserted(nodes []Node, block, after bool) error {
+	separator := r.lossless.newline + r.lossless.newline
+	for _, n := range nodes {
+		if block && after {
+			r.WriteString(separator)
+		}
+		if err := r.renderReformatted(n); nil != err {
+			return err
+		}
+		if block && !after {
+			r.WriteString(separator)
+		}
+	}
+	return nil
+}
+
+// reusable 判断节点 n 是否可以在原文 [start, end) 的基础上渲染：原有子节点在原文中的位置需要按顺序排列并且在 n 的范围内，
+// 子节点列表被修改过时只支持文档和行级容器节点，并且至少要保留一个原有子节点作为插入位置的参照。
+func (l *lossless) reusable(n Node, start, end int) bool {
+	cursor, originals := start, 0
+	for child := n.FirstChild(); nil != child; child = child.Next() {
+		if !l.original(child) {
+			continue
+		}
+		pos := child.Position()
+		if pos.StartOffset < cursor || pos.EndOffset > end {
+			return false
+		}
+		cursor = pos.EndOffset
+		originals++
+	}
+	if !l.childrenChanged(n) {
+		return true
+	}
+
+	switch n.Type() {
+	case NodeDocument, NodeParagraph, NodeHeading, NodeEmphasis, NodeStrong, NodeStrikethrough, NodeLink, NodeImage, NodeTableCell:
+		return 0 < originals
+	}
+	return false
+}
+
+// renderReformatted 重新格式化节点 n。块节点去掉结尾的空行，因为原文中节点后面的换行会原样输出；第一行之后的每一行都需要加上外层容器的前缀。
+func (r *Renderer) renderReformatted(n Node) error {
+	renderer := r.newFormatSubRenderer()
+	renderer.subtreeRoot = n
+	if err := renderer.render(n); nil != err {
+		return err
+	}
+
+	content := renderer.writer.Bytes()
+	if NodeDocument == n.Type() {
+		r.Write(bytes.Replace(content, []byte{itemNewline}, items(r.lossless.newline), -1))
+		return nil
+	}
+	if isBlockNode(n) {
+		content = bytes.TrimRight(content, "\n")
+	}
+
+	prefix := r.lossless.linePrefix(n)
+	blankPrefix := bytes.TrimRight(prefix, " ")
+	for i, line := range bytes.Split(content, []byte{itemNewline}) {
+		if 0 < i {
+			r.WriteString(r.lossless.newline)
+			if 0 < len(line) {
+				r.Write(prefix)
+			} else {
+				r.Write(blankPrefix)
+			}
+		}
+		r.Write(line)
+	}
+	return nil
+}
+
+// linePrefix 返回节点 n 的内容换行后需要加上的外层容器前缀：块引用为 >，列表项为原文中的内容缩进，脚注定义为 4 个空格。
+func (l *lossless) linePrefix(n Node) (ret items) {
+	for parent := n.Parent(); nil != parent; parent = parent.Parent() {
+		switch container := parent.(type) {
+		case *Blockquote:
+			ret = append(items("> "), ret...)
+		case *ListItem:
+			ret = append(bytes.Repeat([]byte{itemSpace}, container.markerOffset+container.padding), ret...)
+		case *FootnotesDef:
+			ret = append(items("    "), ret...)
+		}
+	}
+	return
+}
+
+// isBlockNode 判断节点 n 是否是块节点。
+func isBlockNode(n Node) bool {
+	switch n.Type() {
+	case NodeDocument, NodeParagraph, NodeHeading, NodeThematicBreak, NodeBlockquote, NodeList, NodeListItem, NodeHTMLBlock, NodeCodeBlock,
+		NodeTable, NodeTableHead, NodeTableRow, NodeFootnotesDef, NodeMathBlock, NodeTOC, NodeFrontMatter:
+		return true
+	}
+	return false
+}
@@ -25,11 +25,15 @@ type Lute struct {
 //  * 修正术语拼写
 func New(opts ...option) (ret *Lute) {
 	ret = &Lute{}
+	ret.transformers = defaultTransformers()
 	GFM(true)(ret)
 	SoftBreak2HardBreak(true)(ret)
 	CodeSyntaxHighlight(true)(ret)
+	ChromaCodeStyle("github")(ret)
+	ChromaCodeClassPrefix("highlight-")(ret)
 	AutoSpace(true)(ret)
 	FixTermTypo(true)(ret)
+	TOCLevel(1, 6)(ret)
 	for _, opt := range opts {
 		opt(ret)
 	}
@@ -39,14 +43,13 @@ func New(opts ...option) (ret *Lute) {
 // Markdown 将 markdown 文本字符数组处理为相应的 html 字符数组。name 参数仅用于标识文本，比如可传入 id 或者标题，也可以传入 ""。
 func (lute *Lute) Markdown(name string, markdown []byte) (html []byte, err error) {
 	var tree *Tree
-	tree, err = parse(name, markdown, lute.options)
+	tree, err = lute.Parse(name, markdown)
 	if nil != err {
 		// fmt.Println(string(markdown))
 		return
 	}
 
-	renderer := newHTMLRenderer(lute.options)
-	html, err = tree.render(renderer)
+	html, err = lute.RenderHTML(tree)
 	return
 }
 
@@ -65,14 +68,13 @@ func (lute *Lute) MarkdownStr(name, markdown string) (html string, err error) {
 // Format 将 markdown 文本字符数组进行格式化。
 func (lute *Lute) Format(name string, markdown []byte) (formatted []byte, err error) {
 	var tree *Tree
-	tree, err = parse(name, markdown, lute.options)
+	tree, err = lute.Parse(name, markdown)
 	if nil != err {
 		// fmt.Println(string(markdown))
 		return
 	}
 
-	renderer := newFormatRenderer(lute.options)
-	formatted, err = tree.render(renderer)
+	formatted, err = lute.RenderMarkdown(tree)
 	return
 }
 
@@ -88,6 +90,125 @@ func (lute *Lute) FormatStr(name, markdown string) (formatted string, err error)
 	return
 }
 
+// Parse 将 markdown 文本字符数组解析为一颗语法树。解析得到的语法树可以通过 Walk 进行遍历和修改，然后再使用
+// RenderHTML、RenderMarkdown 等进行渲染，同一颗树可以多次渲染而无需重复解析。
+func (lute *Lute) Parse(name string, markdown []byte) (tree *Tree, err error) {
+	return parse(name, markdown, lute.options)
+}
+
+// RenderHTML 将语法树 tree 渲染为 html 字符数组。
+func (lute *Lute) RenderHTML(tree *Tree) (html []byte, err error) {
+	renderer := newHTMLRenderer(lute.options)
+	return tree.render(renderer)
+}
+
+// RenderMarkdown 将语法树 tree 渲染为格式化过的 markdown 文本字符数组。
+func (lute *Lute) RenderMarkdown(tree *Tree) (markdown []byte, err error) {
+	renderer := newFormatRenderer(lute.options)
+	renderer.lossless = tree.lossless
+	return tree.render(renderer)
+}
+
+// RenderJSON 将语法树 tree 渲染为 JSON 字符数组，包含所有节点的类型、属性以及子节点。
+func (lute *Lute) RenderJSON(tree *Tree) (json []byte, err error) {
+	renderer := newJSONRenderer(lute.options)
+	return tree.render(renderer)
+}
+
+// ParseJSON 将 RenderJSON 渲染得到的 JSON 字符数组还原为一颗语法树。
+func (lute *Lute) ParseJSON(name string, json []byte) (tree *Tree, err error) {
+	return parseJSON(name, json, lute.options)
+}
+
+// SetHTMLRendererFunc 设置节点类型 nodeType 的 HTML 渲染函数 f，f 将替代内置的渲染函数，f 为 nil 时恢复使用内置的渲染函数。
+func (lute *Lute) SetHTMLRendererFunc(nodeType int, f ExtRendererFunc) {
+	lute.htmlRendererFuncs = setExtRendererFunc(lute.htmlRendererFuncs, nodeType, f)
+}
+
+// SetFormatRendererFunc 设置节点类型 nodeType 的格式化渲染函数 f，f 将替代内置的渲染函数，f 为 nil 时恢复使用内置的渲染函数。
+func (lute *Lute) SetFormatRendererFunc(nodeType int, f ExtRendererFunc) {
+	lute.formatRendererFuncs = setExtRendererFunc(lute.formatRendererFuncs, nodeType, f)
+}
+
+// SetJSONRendererFunc 设置节点类型 nodeType 的 JSON 渲染函数 f，f 将替代内置的渲染函数，f 为 nil 时恢复使用内置的渲染函数。
+func (lute *Lute) SetJSONRendererFunc(nodeType int, f ExtRendererFunc) {
+	lute.jsonRendererFuncs = setExtRendererFunc(lute.jsonRendererFuncs, nodeType, f)
+}
+
+// AddInlineExt 添加行级语法扩展 ext。同一个触发字符可以添加多个扩展，解析时按添加顺序尝试，扩展优先于内置解析。
+func (lute *Lute) AddInlineExt(ext *InlineExt) {
+	if nil == lute.inlineExts {
+		lute.inlineExts = map[byte][]*InlineExt{}
+	}
+	lute.inlineExts[ext.Trigger] = append(lute.inlineExts[ext.Trigger], ext)
+}
+
+// AddBlockExt 添加块级语法扩展 ext。同一个触发字符可以添加多个扩展，解析时按添加顺序尝试，扩展优先于内置解析。
+func (lute *Lute) AddBlockExt(ext *BlockExt) {
+	if nil == lute.blockExts {
+		lute.blockExts = map[byte][]*BlockExt{}
+	}
+	lute.blockExts[ext.Trigger] = append(lute.blockExts[ext.Trigger], ext)
+}
+
+// AddTransformer 将变换器 transformer 添加到变换器列表末尾。如果已经存在同名的变换器，则在原位置替换。
+func (lute *Lute) AddTransformer(transformer *Transformer) {
+	for i, tr := range lute.transformers {
+		if tr.Name == transformer.Name {
+			lute.transformers[i] = transformer
+			return
+		}
+	}
+	lute.transformers = append(lute.transformers, transformer)
+}
+
+// RemoveTransformer 移除名称为 name 的变换器，可用于禁用内置变换器。
+func (lute *Lute) RemoveTransformer(name string) {
+	var transformers []*Transformer
+	for _, tr := range lute.transformers {
+		if tr.Name != name {
+			transformers = append(transformers, tr)
+		}
+	}
+	lute.transformers = transformers
+}
+
+// Transformers 返回变换器列表的副本。
+func (lute *Lute) Transformers() []*Transformer {
+	return append([]*Transformer{}, lute.transformers...)
+}
+
+// SetTransformers 设置变换器列表，可用于对变换器重新排序。
+func (lute *Lute) SetTransformers(transformers []*Transformer) {
+	lute.transformers = append([]*Transformer{}, transformers...)
+}
+
+// PutEmojis 添加自定义 Emoji，键为别名，值可以是：
+//  * Unicode 字符，比如 "🐱"
+//  * 图片文件名或者图片地址，比如 "b3log.png"，将被渲染为图片
+//  * :name: 形式的其他 Emoji 别名，比如 ":+1:"
+// 自定义 Emoji 优先于内置 Emoji，可用于覆盖内置 Emoji。
+func (lute *Lute) PutEmojis(emojis map[string]string) {
+	if nil == lute.emojis {
+		lute.emojis = map[string]string{}
+	}
+	for alias, value := range emojis {
+		lute.emojis[alias] = value
+	}
+}
+
+func setExtRendererFunc(funcs map[int]ExtRendererFunc, nodeType int, f ExtRendererFunc) map[int]ExtRendererFunc {
+	if nil == funcs {
+		funcs = map[int]ExtRendererFunc{}
+	}
+	if nil == f {
+		delete(funcs, nodeType)
+	} else {
+		funcs[nodeType] = f
+	}
+	return funcs
+}
+
 // GFM 设置是否打开所有 GFM 支持。
 func GFM(b bool) option {
 	return func(lute *Lute) {
@@ -95,6 +216,7 @@ func GFM(b bool) option {
 		lute.GFMTaskListItem = b
 		lute.GFMStrikethrough = b
 		lute.GFMAutoLink = b
+		lute.GFMTagFilter = b
 	}
 }
 
@@ -126,6 +248,13 @@ func GFMAutoLink(b bool) option {
 	}
 }
 
+// GFMTagFilter 设置是否打开“GFM 标签过滤”支持，原始 HTML 中的 <script>、<style> 等标签开头的 < 将被转义。
+func GFMTagFilter(b bool) option {
+	return func(lute *Lute) {
+		lute.GFMTagFilter = b
+	}
+}
+
 // SoftBreak2HardBreak 设置是否将软换行（\n）渲染为硬换行（<br />）。
 func SoftBreak2HardBreak(b bool) option {
 	return func(lute *Lute) {
@@ -140,6 +269,29 @@ func CodeSyntaxHighlight(b bool) option {
 	}
 }
 
+// ChromaCodeStyle 设置代码块语法高亮使用的 Chroma 样式名称，比如 github、monokai，默认为 github，未知的样式名称将使用 Chroma 的默认样式。
+// 使用 CSS 类输出时需要引入 chroma-styles 目录下相应的样式文件。
+func ChromaCodeStyle(style string) option {
+	return func(lute *Lute) {
+		lute.ChromaCodeStyle = style
+	}
+}
+
+// ChromaCodeClassPrefix 设置代码块语法高亮输出的 CSS 类名前缀，默认为 highlight-，和 chroma-styles 目录下的样式文件一致。
+func ChromaCodeClassPrefix(prefix string) option {
+	return func(lute *Lute) {
+		lute.ChromaCodeClassPrefix = prefix
+	}
+}
+
+// ChromaCodeInlineStyle 设置代码块语法高亮是否使用内联 style 属性输出样式，而不是 CSS 类。
+// 适用于邮件、RSS 等无法引入外部样式的场景。
+func ChromaCodeInlineStyle(b bool) option {
+	return func(lute *Lute) {
+		lute.ChromaCodeInlineStyle = b
+	}
+}
+
 // AutoSpace 设置是否对普通文本中的中西文间自动插入空格。
 // https://github.com/sparanoid/chinese-copywriting-guidelines
 func AutoSpace(b bool) option {
@@ -156,16 +308,302 @@ func FixTermTypo(b bool) option {
 	}
 }
 
+// SourcePos 设置是否在渲染 HTML 时为块级元素添加源码位置属性 data-sourcepos。
+func SourcePos(b bool) option {
+	return func(lute *Lute) {
+		lute.SourcePos = b
+	}
+}
+
+// Footnotes 设置是否打开“脚注”支持。
+func Footnotes(b bool) option {
+	return func(lute *Lute) {
+		lute.Footnotes = b
+	}
+}
+
+// Math 设置是否打开“数学公式”支持，包括行级公式 $tex$ 和公式块 $$。
+func Math(b bool) option {
+	return func(lute *Lute) {
+		lute.Math = b
+	}
+}
+
+// MathConverter 设置数学公式服务端转换函数 f，用于在渲染 HTML 时直接将公式转换为 HTML（比如 KaTeX 渲染结果）。
+// f 为 nil 时输出公式源码，由客户端进行渲染。
+func MathConverter(f MathConvertFunc) option {
+	return func(lute *Lute) {
+		lute.mathConverter = f
+	}
+}
+
+// HeadingID 设置是否为标题生成 id，支持通过 {#id} 自定义 id。
+func HeadingID(b bool) option {
+	return func(lute *Lute) {
+		lute.HeadingID = b
+	}
+}
+
+// HeadingAnchor 设置是否在标题中渲染指向标题自身的锚点链接，需要同时启用 HeadingID。
+func HeadingAnchor(b bool) option {
+	return func(lute *Lute) {
+		lute.HeadingAnchor = b
+	}
+}
+
+// HeadingSlugger 设置标题 id 生成函数 f，f 为 nil 时使用默认的 Slug。
+func HeadingSlugger(f Slugger) option {
+	return func(lute *Lute) {
+		lute.headingSlugger = f
+	}
+}
+
+// TOC 设置是否打开“目录”支持，内容仅为 [toc] 的段落将被渲染为目录。因为目录需要链接到标题，启用后会同时为标题生成 id（支持通过 {#id} 自定义 id）。
+func TOC(b bool) option {
+	return func(lute *Lute) {
+		lute.TOC = b
+	}
+}
+
+// TOCLevel 设置渲染 [toc] 目录时包含的标题级别范围 [min, max]，默认为 [1, 6]。
+func TOCLevel(min, max int) option {
+	return func(lute *Lute) {
+		lute.TOCMinLevel, lute.TOCMaxLevel = min, max
+	}
+}
+
+// FrontMatter 设置是否打开“元数据块”支持，文档开头由 ---（YAML）或者 +++（TOML）包裹的内容将作为元数据。
+// 元数据不会输出到 HTML 中，可以通过 Tree.FrontMatterRaw 获取原始内容或者通过 Tree.FrontMatter 解码。
+func FrontMatter(b bool) option {
+	return func(lute *Lute) {
+		lute.FrontMatter = b
+	}
+}
+
+// FrontMatterDecoder 设置元数据解码函数 f，Tree.FrontMatter 使用该函数代替内置的解码函数，比如使用完整的 YAML、TOML 解析库解码。
+func FrontMatterDecoder(f FrontMatterDecodeFunc) option {
+	return func(lute *Lute) {
+		lute.frontMatterDecoder = f
+	}
+}
+
+// Emoji 设置是否打开“Emoji”支持，:alias: 形式的短码将被渲染为相应的 Emoji。
+func Emoji(b bool) option {
+	return func(lute *Lute) {
+		lute.Emoji = b
+	}
+}
+
+// EmojiSite 设置图片 Emoji 的站点路径，比如 https://static.b3log.org/emoji，图片 Emoji 的地址为站点路径加上图片文件名。
+func EmojiSite(site string) option {
+	return func(lute *Lute) {
+		lute.EmojiSite = site
+	}
+}
+
+// Mentions 设置是否打开“提及”支持，@username 将被解析为提及节点，可以通过 Tree.Mentions 获取所有被提及的用户名。
+func Mentions(b bool) option {
+	return func(lute *Lute) {
+		lute.Mentions = b
+	}
+}
+
+// MentionResolver 设置提及链接解析函数 f，用于在渲染 HTML 时将提及渲染为指向用户的链接，f 为 nil 时按普通文本渲染。
+func MentionResolver(f LinkResolveFunc) option {
+	return func(lute *Lute) {
+		lute.mentionResolver = f
+	}
+}
+
+// Hashtags 设置是否打开“标签”支持，#tag 和 #topic# 将被解析为标签节点，可以通过 Tree.Hashtags 获取所有标签名。
+func Hashtags(b bool) option {
+	return func(lute *Lute) {
+		lute.Hashtags = b
+	}
+}
+
+// HashtagResolver 设置标签链接解析函数 f，用于在渲染 HTML 时将标签渲染为指向标签的链接，f 为 nil 时按普通文本渲染。
+func HashtagResolver(f LinkResolveFunc) option {
+	return func(lute *Lute) {
+		lute.hashtagResolver = f
+	}
+}
+
+// SafeMode 设置是否打开“安全模式”，用于渲染不可信的用户内容：原始 HTML 使用白名单过滤，链接和图片地址中的
+// javascript:、vbscript: 等危险协议将被移除。
+func SafeMode(b bool) option {
+	return func(lute *Lute) {
+		lute.SafeMode = b
+	}
+}
+
+// EscapeRawHTML 设置是否转义所有原始 HTML，启用后原始 HTML 将作为文本显示，优先于 SafeMode 的白名单过滤。
+func EscapeRawHTML(b bool) option {
+	return func(lute *Lute) {
+		lute.EscapeRawHTML = b
+	}
+}
+
+// HTMLAllowlist 设置安全模式下的 HTML 标签和属性白名单 allowlist，键为小写标签名，值为该标签允许使用的小写属性名，
+// 键为 * 时表示所有标签都允许使用的属性。allowlist 为 nil 时使用默认白名单，可以通过 DefaultHTMLAllowlist 获取。
+func HTMLAllowlist(allowlist map[string][]string) option {
+	return func(lute *Lute) {
+		lute.htmlAllowlist = allowlist
+	}
+}
+
+// FormatBulletMarker 设置格式化时无序列表使用的标识符，可以是 -、* 或者 +，为 0 时保留原文中的标识符。
+// 相邻的两个无序列表会交替使用其他标识符，否则它们会被合并为一个列表。
+func FormatBulletMarker(marker byte) option {
+	return func(lute *Lute) {
+		lute.FormatBulletMarker = marker
+	}
+}
+
+// FormatEmphasisMarker 设置格式化时强调使用的标识符，可以是 * 或者 _，默认为 *。
+// 使用 _ 时如果强调前后紧挨着字母或者数字，则仍然使用 *，因为单词内部的 _ 不能构成强调。
+func FormatEmphasisMarker(marker byte) option {
+	return func(lute *Lute) {
+		lute.FormatEmphasisMarker = marker
+	}
+}
+
+// FormatStrongMarker 设置格式化时加粗使用的标识符，可以是 * 或者 _，默认为 *，输出时重复两次。
+// 使用 _ 时如果加粗前后紧挨着字母或者数字，则仍然使用 *。
+func FormatStrongMarker(marker byte) option {
+	return func(lute *Lute) {
+		lute.FormatStrongMarker = marker
+	}
+}
+
+// FormatSetextHeading 设置格式化时一级和二级标题是否使用 Setext 标题（以 === 或者 --- 作为下划线），其他级别的标题总是使用 ATX 标题。
+func FormatSetextHeading(b bool) option {
+	return func(lute *Lute) {
+		lute.FormatSetextHeading = b
+	}
+}
+
+// FormatCodeFence 设置格式化时代码块围栏使用的字符 char 和长度 length。char 可以是 ` 或者 ~，默认为 `；
+// length 小于 3 时保留原文中的围栏长度。代码块内容中包含同样的围栏时会自动加长围栏，信息字符串中包含 ` 时会改用 ~。
+func FormatCodeFence(char byte, length int) option {
+	return func(lute *Lute) {
+		lute.FormatFenceChar = char
+		lute.FormatFenceLength = length
+	}
+}
+
+// FormatOrderedListAllOne 设置格式化时有序列表项是否全部使用列表的起始序号（通常为 1），否则从起始序号开始依次递增编号。
+func FormatOrderedListAllOne(b bool) option {
+	return func(lute *Lute) {
+		lute.FormatOrderedListAllOne = b
+	}
+}
+
+// FormatThematicBreak 设置格式化时分隔线使用的字符串，比如 ***、* * * 或者 ___，默认为 ---，不是合法的分隔线时使用默认值。
+func FormatThematicBreak(thematicBreak string) option {
+	return func(lute *Lute) {
+		lute.FormatThematicBreak = thematicBreak
+	}
+}
+
+// FormatProseWrap 设置格式化时段落的排版方式 mode，可以是：
+//  * ProseWrapPreserve：保留原有的换行，这是默认的排版方式
+//  * ProseWrapAlways：按照宽度 width 重排段落，width 小于 1 时使用 80，CJK 全角字符按照两列计算
+//  * ProseWrapNever：合并软换行，每个段落输出为一行
+//  * ProseWrapSentence：按照句子重排段落，每个句子输出为一行
+// 重排时只会在空格、原有的换行以及 CJK 字符之间换行，不会在代码、链接和行级 HTML 内部换行，也不会让新行被解析为其他块。
+// 注意启用 SoftBreak2HardBreak 时软换行会被渲染为硬换行，重排会改变渲染结果。
+func FormatProseWrap(mode int, width int) option {
+	return func(lute *Lute) {
+		lute.FormatProseWrap = mode
+		lute.FormatProseWrapWidth = width
+	}
+}
+
+// FormatLinkRefDefsSorted 设置格式化时链接引用定义是否按照标签字母顺序输出，否则按照在原文中出现的顺序输出。
+// 链接引用定义统一输出到文档末尾。
+func FormatLinkRefDefsSorted(b bool) option {
+	return func(lute *Lute) {
+		lute.FormatLinkRefDefsSorted = b
+	}
+}
+
+// FormatInlineLinksToRefs 设置格式化时是否将内联链接 [text](dest) 转换为编号引用链接 [text][1]，并在文档末尾输出相应的链接引用定义。
+// 地址和标题都相同的链接使用同一个编号，链接文本就是地址的自动链接不会被转换。
+func FormatInlineLinksToRefs(b bool) option {
+	return func(lute *Lute) {
+		lute.FormatInlineLinksToRefs = b
+	}
+}
+
+// Lossless 设置是否启用无损模式。启用后解析时会保存原文，格式化（RenderMarkdown）时没有修改过的子树原样输出原文（包括空白、
+// 转义、标识符风格以及换行符等），因此格式化未经修改的语法树可以逐字节还原输入；修改过的子树则按照格式化选项重新输出。
+func Lossless(b bool) option {
+	return func(lute *Lute) {
+		lute.Lossless = b
+	}
+}
+
 // options 描述了一些列解析和渲染选项。
 type options struct {
-	GFMTable            bool
-	GFMTaskListItem     bool
-	GFMStrikethrough    bool
-	GFMAutoLink         bool
-	SoftBreak2HardBreak bool
-	CodeSyntaxHighlight bool
-	AutoSpace           bool
-	FixTermTypo         bool
+	GFMTable                bool
+	GFMTaskListItem         bool
+	GFMStrikethrough        bool
+	GFMAutoLink             bool
+	GFMTagFilter            bool
+	SoftBreak2HardBreak     bool
+	CodeSyntaxHighlight     bool
+	AutoSpace               bool
+	FixTermTypo             bool
+	SourcePos               bool
+	Footnotes               bool
+	Math                    bool
+	HeadingID               bool
+	HeadingAnchor           bool
+	TOC                     bool
+	FrontMatter             bool
+	Emoji                   bool
+	Mentions                bool
+	Hashtags                bool
+	SafeMode                bool
+	EscapeRawHTML           bool
+	TOCMinLevel             int
+	TOCMaxLevel             int
+	EmojiSite               string
+	ChromaCodeStyle         string
+	ChromaCodeClassPrefix   string
+	ChromaCodeInlineStyle   bool
+	FormatBulletMarker      byte
+	FormatEmphasisMarker    byte
+	FormatStrongMarker      byte
+	FormatSetextHeading     bool
+	FormatFenceChar         byte
+	FormatFenceLength       int
+	FormatOrderedListAllOne bool
+	FormatThematicBreak     string
+	FormatProseWrap         int
+	FormatProseWrapWidth    int
+	FormatLinkRefDefsSorted bool
+	FormatInlineLinksToRefs bool
+	Lossless                bool
+
+	mathConverter   MathConvertFunc     // 数学公式服务端转换函数
+	headingSlugger  Slugger             // 标题 id 生成函数
+	emojis          map[string]string   // 自定义 Emoji，键为别名
+	mentionResolver LinkResolveFunc     // 提及链接解析函数
+	hashtagResolver LinkResolveFunc     // 标签链接解析函数
+	htmlAllowlist   map[string][]string // 安全模式下的 HTML 标签和属性白名单
+
+	frontMatterDecoder FrontMatterDecodeFunc // 元数据解码函数
+
+	htmlRendererFuncs   map[int]ExtRendererFunc // HTML 扩展渲染函数
+	formatRendererFuncs map[int]ExtRendererFunc // 格式化扩展渲染函数
+	jsonRendererFuncs   map[int]ExtRendererFunc // JSON 扩展渲染函数
+
+	inlineExts map[byte][]*InlineExt // 行级语法扩展，键为触发字符
+	blockExts  map[byte][]*BlockExt  // 块级语法扩展，键为触发字符
+
+	transformers []*Transformer // 语法树变换器列表
 }
 
 // option 描述了解析渲染选项设置函数签名。
@@ -0,0 +1,101 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+)
+
+// MathConvertFunc 描述了数学公式服务端转换函数签名，tex 为公式 TeX 源码，displayMode 为 true 时表示公式块。
+// 返回的内容将直接输出到 HTML 中，返回错误时回退为输出公式源码，由客户端进行渲染。
+type MathConvertFunc func(tex []byte, displayMode bool) (html []byte, err error)
+
+// MathBlock 描述了公式块节点结构，以 $$ 行开始，以 $$ 行结束，内容不会进行 Markdown 解析。
+type MathBlock struct {
+	*BaseNode
+}
+
+func (mathBlock *MathBlock) Continue(context *Context) int {
+	if context.indent <= 3 && isMathBlockFence(context.currentLine[context.nextNonspace:]) {
+		// 闭合 $$ 行，该行已经处理完毕
+		context.finalize(mathBlock, context.lineNum)
+		return 2
+	}
+	return 0
+}
+
+func (mathBlock *MathBlock) Finalize(context *Context) {
+	// 第一行是开始 $$ 行的剩余部分（空白），从第二行开始才是公式内容
+	if i := bytes.IndexByte(mathBlock.tokens, itemNewline); 0 <= i {
+		mathBlock.tokens = mathBlock.tokens[i+1:]
+	} else {
+		mathBlock.tokens = nil
+	}
+}
+
+func (mathBlock *MathBlock) AcceptLines() bool {
+	return true
+}
+
+func (mathBlock *MathBlock) CanContain(nodeType int) bool {
+	return false
+}
+
+// isMathBlockFence 判断 tokens 是否是 $$ 行。
+func isMathBlockFence(tokens items) bool {
+	return 2 <= len(tokens) && itemDollar == tokens[0] && itemDollar == tokens[1] && tokens[2:].isBlankLine()
+}
+
+// parseMathBlock 判断当前行是否是公式块的开始 $$ 行。
+func (t *Tree) parseMathBlock() (ret *MathBlock) {
+	if !isMathBlockFence(t.context.currentLine[t.context.nextNonspace:]) {
+		return nil
+	}
+	return &MathBlock{&BaseNode{typ: NodeMathBlock, tokens: make(items, 0, 256)}}
+}
+
+// InlineMath 描述了行级公式节点结构，以 $ 开始，以 $ 结束，内容不会进行 Markdown 解析。
+type InlineMath struct {
+	*BaseNode
+}
+
+// parseInlineMath 解析行级公式 $tex$。为了避免将普通文本中的美元符号识别为公式，开始 $ 后面和结束 $ 前面不能是空白，
+// 结束 $ 后面也不能是数字，比如 $5 和 $10 不是公式。
+func (t *Tree) parseInlineMath(ctx *InlineContext) (ret Node) {
+	startPos := ctx.pos
+	n := ctx.tokens[startPos:].accept(itemDollar)
+	if 1 != n || ctx.tokensLen <= startPos+1 || isWhitespace(ctx.tokens[startPos+1]) {
+		ctx.pos += n
+		return &Text{tokens: ctx.tokens[startPos : startPos+n]}
+	}
+
+	for i := startPos + 1; i < ctx.tokensLen; i++ {
+		token := ctx.tokens[i]
+		if itemBackslash == token {
+			i++ // 跳过转义字符，比如 \$
+			continue
+		}
+		if itemDollar != token {
+			continue
+		}
+		if isWhitespace(ctx.tokens[i-1]) || (i+1 < ctx.tokensLen && isDigit(ctx.tokens[i+1])) {
+			continue
+		}
+
+		ctx.pos = i + 1
+		return &InlineMath{&BaseNode{typ: NodeInlineMath, tokens: ctx.tokens[startPos+1 : i]}}
+	}
+
+	ctx.pos++
+	return &Text{tokens: ctx.tokens[startPos:ctx.pos]}
+}
@@ -0,0 +1,217 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LinkResolveFunc 描述了链接解析函数签名，name 为被提及的用户名或者标签名，返回链接地址，返回 nil 时按普通文本渲染。
+type LinkResolveFunc func(name string) (dest []byte)
+
+// Mention 描述了提及节点结构，tokens 为 @username。
+type Mention struct {
+	*BaseNode
+}
+
+// Name 返回被提及的用户名。
+func (mention *Mention) Name() string {
+	return fromItems(mention.tokens[1:])
+}
+
+// Hashtag 描述了标签节点结构，tokens 为 #tag 或者 #topic#。
+type Hashtag struct {
+	*BaseNode
+}
+
+// Name 返回标签名。
+func (hashtag *Hashtag) Name() string {
+	tokens := hashtag.tokens[1:]
+	if length := len(tokens); itemCrosshatch == tokens[length-1] {
+		tokens = tokens[:length-1]
+	}
+	return fromItems(tokens)
+}
+
+// Mentions 返回文档中所有被提及的用户名，按第一次出现的顺序排列，已经去重。
+func (t *Tree) Mentions() (ret []string) {
+	return collectNames(t.Root, NodeMention)
+}
+
+// Hashtags 返回文档中所有的标签名，按第一次出现的顺序排列，已经去重。
+func (t *Tree) Hashtags() (ret []string) {
+	return collectNames(t.Root, NodeHashtag)
+}
+
+// collectNames 收集以 root 为根的树中类型为 nodeType 的提及或者标签节点的名称。
+func collectNames(root Node, nodeType int) (ret []string) {
+	seen := map[string]bool{}
+	Walk(root, func(n Node, entering bool) (WalkStatus, error) {
+		if !entering || nodeType != n.Type() {
+			return WalkContinue, nil
+		}
+
+		var name string
+		switch node := n.(type) {
+		case *Mention:
+			name = node.Name()
+		case *Hashtag:
+			name = node.Name()
+		}
+		if !seen[name] {
+			seen[name] = true
+			ret = append(ret, name)
+		}
+		return WalkContinue, nil
+	})
+	return
+}
+
+func (t *Tree) parseMentions(node Node) {
+	for child := node.FirstChild(); nil != child; {
+		next := child.Next()
+		switch child.Type() {
+		case NodeText:
+			t.parseMentions0(child)
+		case NodeLink, NodeImage:
+			// 不处理链接 label 和图片描述（包括其中嵌套的强调等节点），否则会生成嵌套的链接
+		default:
+			t.parseMentions(child) // 递归处理子节点
+		}
+		child = next
+	}
+}
+
+// parseMentions0 将文本节点 node 中的 @username、#tag 和 #topic# 拆分为提及和标签节点。
+// 和 GFM 自动链接一样，@ 和 # 需要在文本开头、空白或者 *、_、~、( 后面，另外为了适应中文语境也可以在非 ASCII 字符后面，
+// 这样邮件地址 a@b.com 和 C# 这样的文本就不会被识别。
+func (t *Tree) parseMentions0(node Node) {
+	tokens := node.Tokens()
+	mentions, hashtags := t.context.option.Mentions, t.context.option.Hashtags
+	if (!mentions || 0 > bytes.IndexByte(tokens, itemAt)) && (!hashtags || 0 > bytes.IndexByte(tokens, itemCrosshatch)) {
+		return
+	}
+	prev := node.Previous()
+
+	var nodes []Node
+	textStart := 0
+	length := len(tokens)
+	for i := 0; i < length; {
+		token := tokens[i]
+		n := 0
+		var typ int
+		if 0 == i || isMentionBoundary(tokens[i-1]) {
+			if itemAt == token && mentions {
+				n, typ = mentionLen(tokens[i:]), NodeMention
+			} else if itemCrosshatch == token && hashtags {
+				n, typ = hashtagLen(tokens[i:]), NodeHashtag
+			}
+		}
+		if 0 == n {
+			i++
+			continue
+		}
+
+		if textStart < i {
+			nodes = append(nodes, &Text{tokens: tokens[textStart:i]})
+		}
+		base := &BaseNode{typ: typ, tokens: tokens[i : i+n]}
+		if NodeMention == typ {
+			nodes = append(nodes, &Mention{base})
+		} else {
+			nodes = append(nodes, &Hashtag{base})
+		}
+		i += n
+		textStart = i
+	}
+	if 1 > len(nodes) {
+		return
+	}
+	if textStart < length {
+		nodes = append(nodes, &Text{tokens: tokens[textStart:]})
+	}
+
+	for _, n := range nodes {
+		node.InsertBefore(node, n)
+	}
+	// 处理完后传入的文本节点 node 已经被拆分为多个节点，所以可以移除自身
+	splitTextPos(node, prev)
+	node.Unlink()
+}
+
+// isMentionBoundary 判断 @ 或者 # 前面的字符 token 是否是合法的边界。
+func isMentionBoundary(token byte) bool {
+	return isWhitespace(token) || itemAsterisk == token || itemUnderscore == token || itemTilde == token ||
+		itemOpenParen == token || utf8.RuneSelf <= token
+}
+
+// mentionLen 返回 tokens 开头的 @username 的长度，不是提及时返回 0。用户名由字母、数字、_ 和 - 组成，结尾的 - 不计入。
+// 用户名后面紧跟 @ 或者 . 加字母数字时可能是邮件地址或者域名，不作为提及。
+func mentionLen(tokens items) int {
+	length := len(tokens)
+	i := 1
+	for ; i < length && (isASCIILetterNum(tokens[i]) || itemUnderscore == tokens[i] || itemHyphen == tokens[i]); i++ {
+	}
+	for ; 1 < i && itemHyphen == tokens[i-1]; i-- {
+	}
+	if 1 == i {
+		return 0
+	}
+
+	if i < length {
+		if itemAt == tokens[i] || (itemDot == tokens[i] && i+1 < length && isASCIILetterNum(tokens[i+1])) {
+			return 0
+		}
+	}
+	return i
+}
+
+// hashtagLen 返回 tokens 开头的 #topic# 或者 #tag 的长度，不是标签时返回 0。#topic# 中间可以是除空白和 # 以外的任意字符，
+// 适合中文这样词之间没有空格的情况；#tag 由字母（包括汉字等 CJK 字符）、数字、_ 和 - 组成，结尾的 - 不计入。
+// 标签名不能全部是数字，因为 #123 通常用来指代问题编号。
+func hashtagLen(tokens items) int {
+	length := len(tokens)
+	i := 1
+	for ; i < length && !isWhitespace(tokens[i]) && itemCrosshatch != tokens[i]; i++ {
+	}
+	if 1 < i && i < length && itemCrosshatch == tokens[i] && !isDigits(tokens[1:i]) {
+		return i + 1
+	}
+
+	i = 1
+	for i < length {
+		r, size := utf8.DecodeRune(tokens[i:])
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && '_' != r && '-' != r {
+			break
+		}
+		i += size
+	}
+	for ; 1 < i && itemHyphen == tokens[i-1]; i-- {
+	}
+	if 1 == i || isDigits(tokens[1:i]) {
+		return 0
+	}
+	return i
+}
+
+// isDigits 判断 tokens 是否都是数字。
+func isDigits(tokens items) bool {
+	for _, token := range tokens {
+		if !isDigit(token) {
+			return false
+		}
+	}
+	return true
+}
@@ -111,27 +111,41 @@ type Node interface {
 
 	// SetLastLineChecked 设置最后一行是否检查过。
 	SetLastLineChecked(bool)
+
+	// Position 返回节点在原文中的位置。
+	Position() *Position
 }
 
 // BaseNode 描述了节点基础结构。
 type BaseNode struct {
-	typ             int    // 节点类型
-	parent          Node   // 父节点
-	previous        Node   // 前一个兄弟节点
-	next            Node   // 后一个兄弟节点
-	firstChild      Node   // 第一个子节点
-	lastChild       Node   // 最后一个子节点
-	rawText         string // 原始内容
-	tokens          items  // 词法分析结果 tokens，语法分析阶段会继续操作这些 tokens
-	close           bool   // 标识是否关闭
-	lastLineBlank   bool   // 标识最后一行是否是空行
-	lastLineChecked bool   // 标识最后一行是否检查过
+	typ             int      // 节点类型
+	parent          Node     // 父节点
+	previous        Node     // 前一个兄弟节点
+	next            Node     // 后一个兄弟节点
+	firstChild      Node     // 第一个子节点
+	lastChild       Node     // 最后一个子节点
+	rawText         string   // 原始内容
+	tokens          items    // 词法分析结果 tokens，语法分析阶段会继续操作这些 tokens
+	close           bool     // 标识是否关闭
+	lastLineBlank   bool     // 标识最后一行是否是空行
+	lastLineChecked bool     // 标识最后一行是否检查过
+	pos             Position // 在原文中的位置
+	lines           *lineMap // tokens 下标到原文位置的映射，仅在需要进行行级解析的节点上使用
+}
+
+// NewBaseNode 创建一个类型为 typ 的基础节点，一般用于在扩展中构造节点。
+func NewBaseNode(typ int) *BaseNode {
+	return &BaseNode{typ: typ}
 }
 
 func (n *BaseNode) Type() int {
 	return n.typ
 }
 
+func (n *BaseNode) Position() *Position {
+	return &n.pos
+}
+
 func (n *BaseNode) IsOpen() bool {
 	return !n.close
 }
@@ -325,4 +339,16 @@ const (
 	NodeTableHead          // 表头节点
 	NodeTableRow           // 表行节点
 	NodeTableCell          // 表格节点
+
+	// 扩展
+
+	NodeFootnotesDef // 脚注定义节点
+	NodeFootnotesRef // 脚注引用节点
+	NodeMathBlock    // 公式块节点
+	NodeInlineMath   // 行级公式节点
+	NodeTOC          // 目录占位节点
+	NodeFrontMatter  // 元数据块节点
+	NodeEmoji        // Emoji 节点
+	NodeMention      // 提及节点
+	NodeHashtag      // 标签节点
 )
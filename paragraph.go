@@ -12,7 +12,10 @@
 
 package lute
 
-import "bytes"
+import (
+	"bytes"
+	"unicode"
+)
 
 // Paragraph 描述了段落节点结构。
 type Paragraph struct {
@@ -31,20 +34,34 @@ func (p *Paragraph) Continue(context *Context) int {
 }
 
 func (p *Paragraph) Finalize(context *Context) {
+	p.lines.trim(len(p.tokens) - len(bytes.TrimLeftFunc(p.tokens, unicode.IsSpace)))
 	p.tokens = bytes.TrimSpace(p.tokens)
 
 	// 尝试解析链接引用定义
 	hasReferenceDefs := false
 	for tokens := p.tokens; 0 < len(tokens) && itemOpenBracket == tokens[0]; tokens = p.tokens {
 		if tokens = context.parseLinkRefDef(tokens); nil != tokens {
+			p.lines.trim(len(p.tokens) - len(tokens))
 			p.tokens = tokens
 			hasReferenceDefs = true
 			continue
 		}
 		break
 	}
-	if hasReferenceDefs && p.tokens.isBlankLine() {
-		p.Unlink()
+	if hasReferenceDefs {
+		if p.tokens.isBlankLine() {
+			p.Unlink()
+		} else {
+			// 段落起始位置后移到链接引用定义之后
+			p.pos.StartLine, p.pos.StartColumn, p.pos.StartOffset = p.lines.locate(0)
+		}
+	}
+
+	if context.option.TOC && isTOCPlaceholder(p.tokens) {
+		// 目录占位段落替换为目录节点，段落节点的移除和表一样在行级解析中进行
+		p.InsertBefore(p, &TableOfContents{&BaseNode{typ: NodeTOC, pos: p.pos, close: true}})
+		p.tokens = nil
+		return
 	}
 
 	if context.option.GFMTaskListItem {
@@ -52,17 +69,17 @@ func (p *Paragraph) Finalize(context *Context) {
 		if listItem, ok := p.parent.(*ListItem); ok {
 			if 3 == listItem.listData.typ {
 				// 如果是任务列表项则添加任务列表标记节点
-				taskListItemMarker := &TaskListItemMarker{&BaseNode{typ: NodeTaskListItemMarker}, listItem.listData.checked}
+				taskListItemMarker := &TaskListItemMarker{&BaseNode{typ: NodeTaskListItemMarker, pos: p.lines.span(0, 3)}, listItem.listData.checked}
 				p.InsertBefore(p, taskListItemMarker)
 				p.tokens = p.tokens[3:] // 剔除开头的 [ ]、[x] 或者 [X]
+				p.lines.trim(3)
 			}
 		}
 	}
 
 	if context.option.GFMTable {
 		// 尝试解析表
-		lines := bytes.Split(p.tokens, []byte{itemNewline})
-		table := context.parseTable(lines)
+		table := context.parseTable(p)
 		if nil != table {
 			p.InsertBefore(p, table)
 			// 移除该段落所有内容 tokens，但段落节点本身暂时保留在语法树上
@@ -24,10 +24,14 @@ func parse(name string, markdown []byte, option options) (tree *Tree, err error)
 	tree = &Tree{Name: name, context: &Context{option: option}}
 	tree.context.tree = tree
 	tree.lexer = newLexer(markdown)
-	tree.Root = &Document{&BaseNode{typ: NodeDocument}}
+	tree.Root = &Document{&BaseNode{typ: NodeDocument, pos: Position{StartLine: 1, StartColumn: 1}}, nil}
 	tree.parseBlocks()
 	tree.parseInlines()
+	tree.transformTree()
 	tree.lexer = nil
+	if option.Lossless {
+		tree.lossless = newLossless(markdown, tree.Root)
+	}
 
 	return
 }
@@ -37,7 +41,8 @@ type Context struct {
 	tree   *Tree   // 关联的语法树
 	option options // 解析渲染选项
 
-	linkRefDef map[string]*Link // 链接引用定义集
+	linkRefDef   map[string]*Link         // 链接引用定义集
+	footnotesDef map[string]*FootnotesDef // 脚注定义集
 
 	// 以下变量用于块级解析阶段
 
@@ -48,6 +53,12 @@ type Context struct {
 	offset, column, nextNonspace, nextNonspaceColumn, indent int   // 解析时用到的下标、缩进空格数
 	indented, blank, partiallyConsumedTab, allClosed         bool  // 是否是缩进行、空行等标识
 	lastMatchedContainer                                     Node  // 最后一个匹配的块节点
+
+	// 以下变量用于记录块级节点的位置
+
+	lineNum, lineOffset         int  // 当前行号、当前行起始字节偏移
+	lastLineLen, lastLineOffset int  // 上一行长度（不含换行符）、上一行起始字节偏移
+	lastLineIsBlank             bool // 上一行是否是空行
 }
 
 // InlineContext 描述了行级元素解析上下文。
@@ -57,6 +68,7 @@ type InlineContext struct {
 	pos        int        // 当前解析到的 token 位置
 	delimiters *delimiter // 分隔符栈，用于强调解析
 	brackets   *delimiter // 括号栈，用于图片和链接解析
+	lines      *lineMap   // tokens 下标到原文位置的映射
 }
 
 func (context *Context) advanceOffset(count int, columns bool) {
@@ -132,7 +144,7 @@ func (context *Context) closeUnmatchedBlocks() {
 	if !context.allClosed {
 		for context.oldtip != context.lastMatchedContainer {
 			parent := context.oldtip.Parent()
-			context.finalize(context.oldtip)
+			context.finalize(context.oldtip, context.lineNum-1)
 			context.oldtip = parent
 		}
 		context.allClosed = true
@@ -140,9 +152,11 @@ func (context *Context) closeUnmatchedBlocks() {
 }
 
 // finalize 执行 block 的最终化处理。调用该方法会将 context.tip 置为 block 的父节点。
-func (context *Context) finalize(block Node) {
+// lineNum 为 block 最后一行的行号，用于记录 block 的结束位置。
+func (context *Context) finalize(block Node, lineNum int) {
 	var parent = block.Parent()
 	block.Close()
+	context.setEndPos(block, lineNum)
 	block.Finalize(context)
 	context.tip = parent
 }
@@ -151,11 +165,12 @@ func (context *Context) finalize(block Node) {
 // 节点并向父节点方向尝试，直到找到一个能接受 child 的节点为止。
 func (context *Context) addChild(child Node) {
 	for !context.tip.CanContain(child.Type()) {
-		context.finalize(context.tip) // 注意调用 finalize 会向父节点方向进行迭代
+		context.finalize(context.tip, context.lineNum-1) // 注意调用 finalize 会向父节点方向进行迭代
 	}
 
 	context.tip.AppendChild(context.tip, child)
 	context.tip = child
+	context.setStartPos(child)
 }
 
 // listsMatch 用户判断指定的 listData 和 itemData 是否可归属于同一个列表。
@@ -172,6 +187,7 @@ type Tree struct {
 	lexer         *lexer         // 词法分析器
 	context       *Context       // 块级解析上下文
 	inlineContext *InlineContext // 行级解析上下文
+	lossless      *lossless      // 无损模式下保存的原文和节点快照
 }
 
 // render 使用 renderer 对语法树 t 进行渲染，渲染结果以 output 返回。
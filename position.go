@@ -0,0 +1,192 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+// Position 描述了节点在 Markdown 原文中的起止位置。
+//
+// 行号和列号都从 1 开始计，列号按字节计算，结束列号指向节点最后一个字节（闭区间）；
+// 字节偏移从 0 开始计，结束偏移指向节点最后一个字节的下一个字节（开区间），即原文切片 [StartOffset:EndOffset] 就是节点内容。
+// 行号为 0 说明该节点没有位置信息，比如解析后通过程序插入的节点。
+type Position struct {
+	StartLine   int `json:"startLine"`   // 起始行号
+	StartColumn int `json:"startColumn"` // 起始列号
+	StartOffset int `json:"startOffset"` // 起始字节偏移
+	EndLine     int `json:"endLine"`     // 结束行号
+	EndColumn   int `json:"endColumn"`   // 结束列号
+	EndOffset   int `json:"endOffset"`   // 结束字节偏移
+}
+
+// setEnd 将 pos 的结束位置设置为 end 的结束位置。
+func (pos *Position) setEnd(end *Position) {
+	if 0 == end.StartLine {
+		return
+	}
+	pos.EndLine, pos.EndColumn, pos.EndOffset = end.EndLine, end.EndColumn, end.EndOffset
+}
+
+// lineSeg 描述了块节点 tokens 中的一个行片段在原文中的起始位置。
+type lineSeg struct {
+	index  int // 片段在 tokens 中的起始下标
+	line   int // 片段起始行号
+	column int // 片段起始列号
+	offset int // 片段起始字节偏移
+}
+
+// lineMap 用于将块节点 tokens 的下标映射回原文位置，行级节点的位置通过它计算得到。
+type lineMap struct {
+	segs  []lineSeg // 行片段，按 index 递增排列
+	shift int       // tokens 头部已经被剔除的字节数
+}
+
+// add 添加一个行片段。
+func (m *lineMap) add(index, line, column, offset int) {
+	m.segs = append(m.segs, lineSeg{index + m.shift, line, column, offset})
+}
+
+// trim 在 tokens 头部被剔除 n 个字节后调用，用于保持下标映射正确。
+func (m *lineMap) trim(n int) {
+	if nil != m {
+		m.shift += n
+	}
+}
+
+// locate 返回 tokens 下标 i 对应的原文行号、列号和字节偏移。
+func (m *lineMap) locate(i int) (line, column, offset int) {
+	if nil == m || 1 > len(m.segs) {
+		return
+	}
+
+	i += m.shift
+	seg := m.segs[0]
+	for _, s := range m.segs[1:] {
+		if s.index > i {
+			break
+		}
+		seg = s
+	}
+	delta := i - seg.index
+	return seg.line, seg.column + delta, seg.offset + delta
+}
+
+// span 返回 tokens 下标区间 [start, end) 对应的原文位置。
+func (m *lineMap) span(start, end int) (ret Position) {
+	if nil == m || start >= end {
+		return
+	}
+
+	ret.StartLine, ret.StartColumn, ret.StartOffset = m.locate(start)
+	ret.EndLine, ret.EndColumn, ret.EndOffset = m.locate(end - 1)
+	ret.EndOffset++
+	return
+}
+
+// setStartPos 将块节点 block 的起始位置设置为当前行的第一个非空字符处。
+func (context *Context) setStartPos(block Node) {
+	pos := block.Position()
+	pos.StartLine = context.lineNum
+	pos.StartColumn = context.nextNonspace + 1
+	pos.StartOffset = context.lineOffset + context.nextNonspace
+}
+
+// setEndPos 将块节点 block 的结束位置设置为第 lineNum 行（当前行或者上一行）的行尾。
+func (context *Context) setEndPos(block Node, lineNum int) {
+	pos := block.Position()
+	if lineNum < pos.StartLine {
+		lineNum = pos.StartLine
+	}
+
+	lineLen, lineOffset := context.lastLineLen, context.lastLineOffset
+	if lineNum == context.lineNum {
+		lineLen, lineOffset = lineContentLen(context.currentLine), context.lineOffset
+	} else if context.lastLineIsBlank {
+		// 容器块以空行结束时，结束位置以最后一个子节点为准
+		if lastChild := block.LastChild(); nil != lastChild && 0 < lastChild.Position().EndLine {
+			pos.setEnd(lastChild.Position())
+			return
+		}
+	}
+	pos.EndLine = lineNum
+	pos.EndColumn = lineLen
+	pos.EndOffset = lineOffset + lineLen
+}
+
+// lineContentLen 返回行 line 去掉结尾换行符后的长度。
+func lineContentLen(line items) int {
+	length := len(line)
+	if 0 < length && (itemNewline == line[length-1] || itemCarriageReturn == line[length-1]) {
+		length--
+	}
+	return length
+}
+
+// setPos 将行级解析 tokens 下标区间 [start, end) 对应的原文位置设置到节点 n 上。
+func (ctx *InlineContext) setPos(n Node, start, end int) {
+	*n.Position() = ctx.lines.span(start, end)
+}
+
+// setDelimPos 在强调分隔符配对成功后计算 opener、closer 以及生成的强调节点 emStrongDel 的位置，useDelims 为配对使用的分隔符个数。
+func setDelimPos(opener, closer, emStrongDel Node, useDelims int) {
+	openerPos, closerPos := opener.Position(), closer.Position()
+	if nil == emStrongDel || 0 == openerPos.StartLine || 0 == closerPos.StartLine {
+		return
+	}
+
+	// 使用的是开始分隔符的最后 useDelims 个以及结束分隔符的最前 useDelims 个
+	openerPos.EndColumn -= useDelims
+	openerPos.EndOffset -= useDelims
+	pos := emStrongDel.Position()
+	pos.StartLine, pos.StartColumn, pos.StartOffset = openerPos.EndLine, openerPos.EndColumn+1, openerPos.EndOffset
+	pos.EndLine, pos.EndColumn, pos.EndOffset = closerPos.StartLine, closerPos.StartColumn+useDelims-1, closerPos.StartOffset+useDelims
+	closerPos.StartColumn += useDelims
+	closerPos.StartOffset += useDelims
+}
+
+// subPos 返回文本节点 text 中 tokens 区间 [start, end) 对应的原文位置。
+// 如果文本节点的 tokens 和原文不是一一对应的（比如包含了实体、转义或者跨行），则无法精确计算，此时直接返回文本节点的位置。
+func subPos(text Node, start, end int) Position {
+	pos := text.Position()
+	if pos.StartLine != pos.EndLine || pos.EndOffset-pos.StartOffset != len(text.Tokens()) {
+		return *pos
+	}
+
+	return Position{pos.StartLine, pos.StartColumn + start, pos.StartOffset + start, pos.StartLine, pos.StartColumn + end - 1, pos.StartOffset + end}
+}
+
+// splitTextPos 为文本节点 text 拆分后插入到其前面的节点计算位置，prev 是拆分前 text 的前一个兄弟节点。
+// 拆分出的节点（文本或者链接）按顺序覆盖了 text 的 tokens，所以可以按长度依次累加计算。
+func splitTextPos(text, prev Node) {
+	if 0 == text.Position().StartLine {
+		return
+	}
+
+	var n Node
+	if nil != prev {
+		n = prev.Next()
+	} else {
+		n = text.Parent().FirstChild()
+	}
+
+	i := 0
+	for ; nil != n && n != text; n = n.Next() {
+		length := len(n.Tokens())
+		label := n.FirstChild()
+		if NodeLink == n.Type() && nil != label {
+			length = len(label.Tokens())
+		}
+		*n.Position() = subPos(text, i, i+length)
+		if nil != label {
+			*label.Position() = *n.Position()
+		}
+		i += length
+	}
+}
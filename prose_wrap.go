@@ -0,0 +1,275 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// ProseWrapPreserve 保留段落中原有的换行，这是默认的段落排版方式。
+	ProseWrapPreserve = iota
+	// ProseWrapAlways 按照指定宽度重排段落，内容超出宽度时换行。
+	ProseWrapAlways
+	// ProseWrapNever 合并段落中的软换行，每个段落输出为一行。
+	ProseWrapNever
+	// ProseWrapSentence 按照句子重排段落，每个句子输出为一行。
+	ProseWrapSentence
+)
+
+// cjkClosingPunct 列出了不能出现在行首的标点符号，也就是不能在这些符号前面换行。
+const cjkClosingPunct = "，。、；：？！）》」』】〉〕〗〙〛”’…—～·"
+
+// cjkOpeningPunct 列出了不能出现在行尾的标点符号，也就是不能在这些符号后面换行。
+const cjkOpeningPunct = "（《「『【〈〔〖〘〚“‘"
+
+// cjkSentenceEnd 列出了中日文句子结尾标点。
+const cjkSentenceEnd = "。！？"
+
+// prose 描述了段落重排时段落的行级内容。
+type prose struct {
+	content items        // 格式化后的行级内容，不包括换行
+	atoms   [][2]int     // 不能断行的区间，比如代码、链接和行级 HTML
+	breaks  map[int]bool // 原有的换行位置，值为 true 时表示硬换行
+}
+
+// proseUnit 描述了段落重排时不能再拆分的一段内容。
+type proseUnit struct {
+	text items  // 内容
+	sep  string // 和上一段内容之间不换行时使用的分隔符
+	hard bool   // 和上一段内容之间是否是硬换行
+}
+
+// newProse 使用一个新的渲染器渲染段落 paragraph 的行级内容，同时记录换行位置以及不能断行的区间。
+func (r *Renderer) newProse(paragraph Node) (ret *prose, err error) {
+	ret = &prose{breaks: map[int]bool{}}
+	renderer := r.newFormatSubRenderer()
+	walker := func(n Node, entering bool) (WalkStatus, error) {
+		switch n.Type() {
+		case NodeSoftBreak, NodeHardBreak:
+			if entering {
+				hard := NodeHardBreak == n.Type()
+				if hard && !r.option.SoftBreak2HardBreak {
+					renderer.writeByte(itemBackslash)
+				}
+				ret.breaks[renderer.writer.Len()] = hard
+			}
+			return WalkContinue, nil
+		case NodeCodeSpan, NodeLink, NodeImage, NodeInlineHTML, NodeInlineMath, NodeEmoji, NodeMention, NodeHashtag, NodeFootnotesRef:
+			if entering {
+				start := renderer.writer.Len()
+				if err := Walk(n, renderer.renderNode); nil != err {
+					return WalkStop, err
+				}
+				ret.atoms = append(ret.atoms, [2]int{start, renderer.writer.Len()})
+			}
+			return WalkSkipChildren, nil
+		}
+		return renderer.renderNode(n, entering)
+	}
+	for child := paragraph.FirstChild(); nil != child; child = child.Next() {
+		if err = Walk(child, walker); nil != err {
+			return
+		}
+	}
+	ret.content = renderer.writer.Bytes()
+	return
+}
+
+// units 在可以断行的位置将内容拆分为多段。可以断行的位置包括空格、原有的换行以及两个 CJK 字符之间（需要遵守行首行尾禁则）。
+func (p *prose) units() (ret []*proseUnit) {
+	length := len(p.content)
+	start, atom, handled := 0, 0, -1
+	sep, hard := "", false
+	cut := func(end, next int, nextSep string, nextHard bool) {
+		if start < end {
+			ret = append(ret, &proseUnit{text: p.content[start:end], sep: sep, hard: hard})
+			sep, hard = nextSep, nextHard
+		} else { // 连续的断行位置合并为一个
+			hard = hard || nextHard
+			if "" == sep {
+				sep = nextSep
+			}
+		}
+		start = next
+	}
+
+	for i := 0; i < length; {
+		if brk, ok := p.breaks[i]; ok && handled != i {
+			handled = i
+			before, _ := utf8.DecodeLastRune(p.content[:i])
+			after, _ := utf8.DecodeRune(p.content[i:])
+			brkSep := " "
+			if 2 == runeWidth(before) && 2 == runeWidth(after) {
+				brkSep = "" // 两个 CJK 字符之间的软换行合并时不需要空格
+			}
+			cut(i, i, brkSep, brk)
+			continue
+		}
+		if atom < len(p.atoms) && i == p.atoms[atom][0] {
+			i = p.atoms[atom][1]
+			atom++
+			continue
+		}
+
+		if itemSpace == p.content[i] {
+			j := i
+			for j < length && itemSpace == p.content[j] {
+				j++
+			}
+			cut(i, j, fromItems(p.content[i:j]), false)
+			i = j
+			continue
+		}
+
+		r, size := utf8.DecodeRune(p.content[i:])
+		if start < i {
+			if before, _ := utf8.DecodeLastRune(p.content[:i]); isCJKBreakable(before, r) {
+				cut(i, i, "", false)
+			}
+		}
+		i += size
+	}
+	if start < length {
+		ret = append(ret, &proseUnit{text: p.content[start:], sep: sep, hard: hard})
+	}
+	return
+}
+
+// isCJKBreakable 判断是否可以在字符 before 和 after 之间换行。两个 CJK 字符之间可以换行，句子结尾标点后面也可以换行，
+// 但是行首不能是结束标点，行尾不能是开始标点。
+func isCJKBreakable(before, after rune) bool {
+	if strings.ContainsRune(cjkClosingPunct, after) || strings.ContainsRune(cjkOpeningPunct, before) {
+		return false
+	}
+	return (2 == runeWidth(before) && 2 == runeWidth(after)) || strings.ContainsRune(cjkSentenceEnd, before)
+}
+
+// renderProse 按照格式化选项重排段落 paragraph 的内容。
+func (r *Renderer) renderProse(paragraph Node) error {
+	p, err := r.newProse(paragraph)
+	if nil != err {
+		return err
+	}
+	units := p.units()
+
+	// 断行时不能让新行被解析为其他块，所以需要检查新行的开头，这里先拼接出不换行时的完整内容
+	var joined bytes.Buffer
+	offsets := make([]int, len(units))
+	for i, unit := range units {
+		joined.WriteString(unit.sep)
+		offsets[i] = joined.Len()
+		joined.Write(unit.text)
+	}
+
+	width := r.option.FormatProseWrapWidth
+	if 1 > width {
+		width = 80
+	}
+	prefix, indent := r.linePrefix(paragraph)
+	prefixWidth := indent + displayWidth(prefix)
+	buf := r.writer.Bytes()
+	column := indent + displayWidth(buf[bytes.LastIndexByte(buf, itemNewline)+1:])
+	for i, unit := range units {
+		if 0 < i {
+			newline := unit.hard
+			if !newline && canBreakBefore(joined.Bytes()[offsets[i]:]) && !bytes.HasSuffix(units[i-1].text, []byte{itemBackslash}) {
+				switch r.option.FormatProseWrap {
+				case ProseWrapAlways:
+					line := unit.text
+					if end := bytes.IndexByte(line, itemNewline); 0 <= end {
+						line = line[:end]
+					}
+					newline = column+len(unit.sep)+displayWidth(line) > width
+				case ProseWrapSentence:
+					newline = isSentenceEnd(units[i-1].text)
+				}
+			}
+
+			if newline {
+				r.writeByte(itemNewline)
+				r.Write(prefix)
+				column = prefixWidth
+			} else {
+				r.WriteString(unit.sep)
+				column += len(unit.sep)
+			}
+		}
+
+		// 代码、行级 HTML 等内容中可能包含换行，换行后也需要加上前缀
+		lines := bytes.Split(unit.text, []byte{itemNewline})
+		for j, line := range lines {
+			if 0 < j {
+				r.writeByte(itemNewline)
+				r.Write(prefix)
+				column = prefixWidth
+			}
+			r.Write(line)
+			column += displayWidth(line)
+		}
+	}
+	return nil
+}
+
+// canBreakBefore 判断以 line 开头的新行是否仍然属于段落，也就是不会被解析为标题、列表、块引用、代码块、HTML 块、分隔线、
+// Setext 标题下划线、表格分隔行、公式块或者脚注定义。这里的判断比较保守，宁可不换行也不能改变文档结构。
+func canBreakBefore(line items) bool {
+	if 1 > len(line) {
+		return true
+	}
+
+	word := line
+	if end := bytes.IndexByte(line, itemSpace); 0 <= end {
+		word = line[:end]
+	}
+	if 0 == len(bytes.Trim(word, "-=*_+")) || 0 == len(bytes.Trim(word, "|:-")) {
+		return false // 列表标识符、分隔线、Setext 标题下划线或者表格分隔行
+	}
+
+	switch line[0] {
+	case itemCrosshatch:
+		level := len(word) - len(bytes.TrimLeft(word, "#"))
+		return 6 < level || level < len(word)
+	case itemGreater, itemLess:
+		return false
+	}
+	if bytes.HasPrefix(line, []byte("```")) || bytes.HasPrefix(line, []byte("~~~")) || bytes.HasPrefix(line, []byte("$$")) {
+		return false
+	}
+	if label, n := parseFootnotesLabel(line); nil != label && n < len(line) && itemColon == line[n] {
+		return false
+	}
+
+	digits := len(word) - len(bytes.TrimLeft(word, "0123456789"))
+	return 0 == digits || digits == len(word) || (itemDot != word[digits] && itemCloseParen != word[digits]) || digits+1 < len(word)
+}
+
+// isSentenceEnd 判断 text 是否以句子结尾标点结束，结尾标点后面可以跟着右括号和引号。单个字母加 . 一般是名字缩写，不作为句子结尾。
+func isSentenceEnd(text items) bool {
+	text = bytes.TrimRight(text, ")]\"'”’」』）")
+	if 1 > len(text) {
+		return false
+	}
+	if last, _ := utf8.DecodeLastRune(text); strings.ContainsRune(cjkSentenceEnd, last) {
+		return true
+	}
+	switch text[len(text)-1] {
+	case '!', '?':
+		return true
+	case itemDot:
+		return 2 < len(text) || (2 == len(text) && !isASCIILetter(text[0]))
+	}
+	return false
+}
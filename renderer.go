@@ -21,15 +21,24 @@ import (
 // RendererFunc 描述了渲染器函数签名。
 type RendererFunc func(n Node, entering bool) (WalkStatus, error)
 
+// ExtRendererFunc 描述了扩展渲染函数签名，扩展渲染函数通过渲染器 r 进行输出。
+type ExtRendererFunc func(r *Renderer, n Node, entering bool) (WalkStatus, error)
+
 // Renderer 描述了渲染器结构。
 type Renderer struct {
-	writer        bytes.Buffer         // 输出缓冲
-	lastOut       byte                 // 最新输出的一个字节
-	rendererFuncs map[int]RendererFunc // 渲染器
-	disableTags   int                  // 标签嵌套计数器，用于判断不可能出现标签嵌套的情况，比如语法树允许图片节点包含链接节点，但是 HTML <img> 不能包含 <a>。
-	option        options              // 解析渲染选项
+	writer           bytes.Buffer            // 输出缓冲
+	lastOut          byte                    // 最新输出的一个字节
+	rendererFuncs    map[int]RendererFunc    // 渲染器
+	extRendererFuncs map[int]ExtRendererFunc // 扩展渲染器，优先于 rendererFuncs 使用
+	disableTags      int                     // 标签嵌套计数器，用于判断不可能出现标签嵌套的情况，比如语法树允许图片节点包含链接节点，但是 HTML <img> 不能包含 <a>。
+	option           options                 // 解析渲染选项
+
+	listLevel int        // 列表级别，用于记录嵌套列表深度
+	footnotes *footnotes // 脚注编号信息
+	linkRefs  *linkRefs  // 链接引用信息，格式化时使用
 
-	listLevel int // 列表级别，用于记录嵌套列表深度
+	subtreeRoot Node      // 子树渲染时的根节点，根节点外层容器（比如列表项）的缩进由调用方处理
+	lossless    *lossless // 无损模式下解析时保存的原文和节点快照，格式化时使用
 }
 
 // render 从指定的根节点 root 开始遍历并渲染。
@@ -37,14 +46,29 @@ func (r *Renderer) render(root Node) error {
 	r.lastOut = itemNewline
 	r.writer.Grow(4096)
 
-	return Walk(root, func(n Node, entering bool) (WalkStatus, error) {
-		f := r.rendererFuncs[n.Type()]
-		if nil == f {
-			return WalkStop, errors.New(fmt.Sprintf("not found render function for node [type=%d, text=%s]", n.Type(), n.RawText()))
-		}
+	if nil != r.lossless {
+		return r.renderLossless(root)
+	}
+	return Walk(root, r.renderNode)
+}
+
+// renderNode 渲染节点 n，优先使用扩展渲染函数。
+func (r *Renderer) renderNode(n Node, entering bool) (WalkStatus, error) {
+	if f := r.extRendererFuncs[n.Type()]; nil != f {
+		return f(r, n, entering)
+	}
+
+	return r.RenderDefault(n, entering)
+}
+
+// RenderDefault 使用内置的渲染函数渲染节点 n，一般在扩展渲染函数中调用，用于在内置渲染结果前后添加内容或者处理不需要定制的情况。
+func (r *Renderer) RenderDefault(n Node, entering bool) (WalkStatus, error) {
+	f := r.rendererFuncs[n.Type()]
+	if nil == f {
+		return WalkStop, errors.New(fmt.Sprintf("not found render function for node [type=%d, text=%s]", n.Type(), n.RawText()))
+	}
 
-		return f(n, entering)
-	})
+	return f(n, entering)
 }
 
 // writeByte 输出一个字节 c。
@@ -53,24 +77,24 @@ func (r *Renderer) writeByte(c byte) {
 	r.lastOut = c
 }
 
-// write 输出指定的 tokens 数组 content。
-func (r *Renderer) write(content items) {
+// Write 输出指定的 tokens 数组 content。
+func (r *Renderer) Write(content items) {
 	if length := len(content); 0 < length {
 		r.writer.Write(content)
 		r.lastOut = content[length-1]
 	}
 }
 
-// writeString 输出指定的字符串 content。
-func (r *Renderer) writeString(content string) {
+// WriteString 输出指定的字符串 content。
+func (r *Renderer) WriteString(content string) {
 	if length := len(content); 0 < length {
 		r.writer.WriteString(content)
 		r.lastOut = content[length-1]
 	}
 }
 
-// newline 会在最新内容不是换行符 \n 时输出一个换行符。
-func (r *Renderer) newline() {
+// Newline 会在最新内容不是换行符 \n 时输出一个换行符。
+func (r *Renderer) Newline() {
 	if itemNewline != r.lastOut {
 		r.writer.WriteByte(itemNewline)
 		r.lastOut = itemNewline
@@ -0,0 +1,346 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+	"html"
+	"strings"
+)
+
+// defaultHTMLAllowlist 是默认的 HTML 标签和属性白名单，键为标签名，值为该标签允许使用的属性。
+var defaultHTMLAllowlist = map[string][]string{
+	"a":          {"href", "title", "name"},
+	"abbr":       {"title"},
+	"b":          nil,
+	"blockquote": {"cite"},
+	"br":         nil,
+	"code":       nil,
+	"dd":         nil,
+	"del":        nil,
+	"details":    {"open"},
+	"div":        nil,
+	"dl":         nil,
+	"dt":         nil,
+	"em":         nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title", "width", "height"},
+	"ins":        nil,
+	"kbd":        nil,
+	"li":         nil,
+	"mark":       nil,
+	"ol":         {"start"},
+	"p":          nil,
+	"pre":        nil,
+	"q":          {"cite"},
+	"s":          nil,
+	"samp":       nil,
+	"small":      nil,
+	"span":       nil,
+	"strike":     nil,
+	"strong":     nil,
+	"sub":        nil,
+	"summary":    nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"align", "colspan", "rowspan"},
+	"tfoot":      nil,
+	"th":         {"align", "colspan", "rowspan"},
+	"thead":      nil,
+	"tr":         nil,
+	"u":          nil,
+	"ul":         nil,
+	"var":        nil,
+}
+
+// DefaultHTMLAllowlist 返回默认的 HTML 标签和属性白名单的副本，可以在此基础上修改后通过 HTMLAllowlist 设置。
+func DefaultHTMLAllowlist() map[string][]string {
+	ret := map[string][]string{}
+	for tag, attrs := range defaultHTMLAllowlist {
+		ret[tag] = append([]string(nil), attrs...)
+	}
+	return ret
+}
+
+var (
+	// urlAttrs 用于列出值为 URL 的属性，过滤 HTML 时需要检查这些属性值的协议。
+	urlAttrs = map[string]bool{"href": true, "src": true, "cite": true, "action": true, "formaction": true, "background": true, "poster": true}
+
+	// dropContentTags 用于列出过滤时需要连同内容一起移除的标签。
+	dropContentTags = map[string]bool{"script": true, "style": true, "iframe": true, "textarea": true, "title": true, "xmp": true, "noscript": true, "noembed": true, "noframes": true}
+)
+
+// gfmDisallowedTags 用于列出 GFM 规范中不允许使用的原始 HTML 标签。
+// https://github.github.com/gfm/#disallowed-raw-html-extension-
+var gfmDisallowedTags = []string{"title", "textarea", "style", "xmp", "iframe", "noembed", "noframes", "script", "plaintext"}
+
+// filterGFMDisallowedTags 将原始 HTML 片段 tokens 中 GFM 不允许使用的标签开头的 < 转义为 &lt;，使其作为文本显示。
+func filterGFMDisallowedTags(tokens items) (ret items) {
+	i := bytes.IndexByte(tokens, itemLess)
+	if 0 > i {
+		return tokens
+	}
+
+	ret = make(items, 0, len(tokens)+16)
+	for ; 0 <= i; i = bytes.IndexByte(tokens, itemLess) {
+		ret = append(ret, tokens[:i]...)
+		if isGFMDisallowedTag(tokens[i:]) {
+			ret = append(ret, items("&lt;")...)
+		} else {
+			ret = append(ret, itemLess)
+		}
+		tokens = tokens[i+1:]
+	}
+	return append(ret, tokens...)
+}
+
+// isGFMDisallowedTag 判断 tokens 是否以 GFM 不允许使用的开始标签或者结束标签开头，标签名不区分大小写。
+func isGFMDisallowedTag(tokens items) bool {
+	tokens = tokens[1:] // 跳过 <
+	if 0 < len(tokens) && itemSlash == tokens[0] {
+		tokens = tokens[1:]
+	}
+	for _, tag := range gfmDisallowedTags {
+		length := len(tag)
+		if len(tokens) < length || !bytes.EqualFold(tokens[:length], items(tag)) {
+			continue
+		}
+		if len(tokens) == length {
+			return true
+		}
+		if next := tokens[length]; isWhitespace(next) || itemGreater == next || itemSlash == next {
+			return true
+		}
+	}
+	return false
+}
+
+// isSafeURL 判断链接地址 dest 是否是安全的，javascript:、vbscript:、file: 以及除常见图片以外的 data: 协议被认为是不安全的。
+func isSafeURL(dest items) bool {
+	// 浏览器会忽略协议中的空白和控制字符，所以判断前需要去掉
+	var scheme []byte
+	hasScheme := false
+	for _, token := range dest {
+		if itemColon == token {
+			hasScheme = true
+			break
+		}
+		if itemSlash == token || itemQuestion == token || itemCrosshatch == token {
+			return true // 没有协议的相对地址
+		}
+		if !isWhitespace(token) && !isControl(token) {
+			scheme = append(scheme, token)
+		}
+	}
+	if !hasScheme {
+		return true
+	}
+
+	switch strings.ToLower(string(scheme)) {
+	case "javascript", "vbscript", "file":
+		return false
+	case "data":
+		lowerDest := bytes.ToLower(dest)
+		for _, prefix := range []string{"data:image/png", "data:image/gif", "data:image/jpeg", "data:image/webp"} {
+			if bytes.HasPrefix(lowerDest, []byte(prefix)) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// sanitizeHTML 使用白名单 allowlist 过滤原始 HTML 片段 tokens。白名单中的标签保留，但只保留白名单允许的属性（键为 * 的属性
+// 所有标签都允许），URL 属性的值还需要通过 isSafeURL 检查；不在白名单中的标签被移除，script、style 等标签连同其内容一起移除；
+// 注释、声明、处理指令和 CDATA 被移除，无法构成标签的 < 被转义。保留的属性值会重新转义并使用双引号包裹，所以输出中不会出现能够闭合属性的字符。
+func sanitizeHTML(tokens items, allowlist map[string][]string) (ret items) {
+	if nil == allowlist {
+		allowlist = defaultHTMLAllowlist
+	}
+
+	ret = make(items, 0, len(tokens))
+	length := len(tokens)
+	for i := 0; i < length; {
+		token := tokens[i]
+		if itemLess != token {
+			if itemGreater == token {
+				ret = append(ret, items("&gt;")...)
+			} else {
+				ret = append(ret, token)
+			}
+			i++
+			continue
+		}
+
+		remains := tokens[i:]
+		if bytes.HasPrefix(remains, items("<!--")) {
+			i += skipUntil(remains, items("-->"))
+			continue
+		}
+		if 1 < len(remains) && (itemBang == remains[1] || itemQuestion == remains[1]) {
+			i += skipUntil(remains, items(">"))
+			continue
+		}
+
+		tag, n := parseHTMLTag(remains)
+		if nil == tag {
+			ret = append(ret, items("&lt;")...)
+			i++
+			continue
+		}
+		i += n
+
+		attrs, allowed := allowlist[tag.name]
+		if !allowed {
+			if !tag.closing && !tag.selfclosing && dropContentTags[tag.name] {
+				i += skipUntil(tokens[i:], items("</"+tag.name))
+				i += skipUntil(tokens[i:], items(">"))
+			}
+			continue
+		}
+		ret = append(ret, tag.render(attrs, allowlist["*"])...)
+	}
+	return
+}
+
+// skipUntil 返回 tokens 中从开头到 end 结束（包括 end，不区分大小写）的长度，找不到 end 时返回 tokens 的长度。
+func skipUntil(tokens, end items) int {
+	if i := bytes.Index(bytes.ToLower(tokens), end); 0 <= i {
+		return i + len(end)
+	}
+	return len(tokens)
+}
+
+// htmlTag 描述了过滤 HTML 时解析得到的标签。
+type htmlTag struct {
+	name        string      // 小写标签名
+	attrs       [][2]string // 属性名（小写）和未转义的属性值
+	closing     bool        // 是否是结束标签
+	selfclosing bool        // 是否以 /> 结尾
+}
+
+// parseHTMLTag 解析 tokens 开头的开始标签或者结束标签，返回标签以及标签的长度，解析失败返回 nil。
+func parseHTMLTag(tokens items) (ret *htmlTag, n int) {
+	length := len(tokens)
+	ret = &htmlTag{}
+	i := 1
+	if i < length && itemSlash == tokens[i] {
+		ret.closing = true
+		i++
+	}
+	start := i
+	for ; i < length && (isASCIILetterNum(tokens[i]) || itemHyphen == tokens[i]); i++ {
+	}
+	if start == i || !isASCIILetter(tokens[start]) {
+		return nil, 0
+	}
+	ret.name = strings.ToLower(fromItems(tokens[start:i]))
+
+	for i < length {
+		token := tokens[i]
+		if isWhitespace(token) {
+			i++
+			continue
+		}
+		if itemGreater == token {
+			return ret, i + 1
+		}
+		if itemSlash == token {
+			if i+1 < length && itemGreater == tokens[i+1] {
+				ret.selfclosing = true
+				return ret, i + 2
+			}
+			i++
+			continue
+		}
+
+		// 属性名
+		start = i
+		for ; i < length && !isWhitespace(tokens[i]) && itemSlash != tokens[i] && itemGreater != tokens[i] && itemEqual != tokens[i]; i++ {
+		}
+		name := strings.ToLower(fromItems(tokens[start:i]))
+		for ; i < length && isWhitespace(tokens[i]); i++ {
+		}
+		if i >= length || itemEqual != tokens[i] {
+			ret.attrs = append(ret.attrs, [2]string{name, ""})
+			continue
+		}
+
+		// 属性值
+		for i++; i < length && isWhitespace(tokens[i]); i++ {
+		}
+		if i >= length {
+			break
+		}
+		var value items
+		if quote := tokens[i]; itemDoublequote == quote || itemSinglequote == quote {
+			end := bytes.IndexByte(tokens[i+1:], quote)
+			if 0 > end {
+				break
+			}
+			value = tokens[i+1 : i+1+end]
+			i += end + 2
+		} else {
+			start = i
+			for ; i < length && !isWhitespace(tokens[i]) && itemGreater != tokens[i]; i++ {
+			}
+			value = tokens[start:i]
+		}
+		ret.attrs = append(ret.attrs, [2]string{name, html.UnescapeString(fromItems(value))})
+	}
+	return nil, 0
+}
+
+// render 输出只包含允许的属性 allowedAttrs 和全局属性 globalAttrs 的标签。
+func (tag *htmlTag) render(allowedAttrs, globalAttrs []string) (ret items) {
+	if tag.closing {
+		return items("</" + tag.name + ">")
+	}
+
+	ret = append(ret, items("<"+tag.name)...)
+	for _, attr := range tag.attrs {
+		name, value := attr[0], attr[1]
+		if !containsString(allowedAttrs, name) && !containsString(globalAttrs, name) {
+			continue
+		}
+		if urlAttrs[name] && !isSafeURL(items(value)) {
+			continue
+		}
+		ret = append(ret, items(" "+name+"=\"")...)
+		ret = append(ret, escapeHTML(items(value))...)
+		ret = append(ret, itemDoublequote)
+	}
+	if tag.selfclosing {
+		ret = append(ret, items(" /")...)
+	}
+	return append(ret, itemGreater)
+}
+
+// containsString 判断字符串数组 strs 是否包含 str。
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -12,7 +12,10 @@
 
 package lute
 
-import "bytes"
+import (
+	"bytes"
+	"unicode"
+)
 
 // Table 描述了表节点结构。
 type Table struct {
@@ -37,7 +40,8 @@ type TableCell struct {
 	Aligns int
 }
 
-func (context *Context) parseTable(lines [][]byte) (ret *Table) {
+func (context *Context) parseTable(paragraph *Paragraph) (ret *Table) {
+	lines := bytes.Split(paragraph.tokens, []byte{itemNewline})
 	length := len(lines)
 	if 2 > length {
 		return
@@ -48,16 +52,22 @@ func (context *Context) parseTable(lines [][]byte) (ret *Table) {
 		return
 	}
 
-	headRow := context.parseTableRow(bytes.TrimSpace(lines[0]), aligns, true)
+	// 计算每行在段落 tokens 中的起始下标，用于计算位置
+	starts := make([]int, length)
+	for i := 1; i < length; i++ {
+		starts[i] = starts[i-1] + len(lines[i-1]) + 1
+	}
+
+	headRow := context.parseTableRow(lines[0], starts[0], paragraph.lines, aligns, true)
 	if nil == headRow {
 		return
 	}
 
-	ret = &Table{&BaseNode{typ: NodeTable}, aligns}
+	ret = &Table{&BaseNode{typ: NodeTable, pos: paragraph.pos}, aligns}
 	ret.Aligns = aligns
 	ret.AppendChild(ret, context.newTableHead(headRow))
 	for i := 2; i < length; i++ {
-		tableRow := context.parseTableRow(bytes.TrimSpace(lines[i]), aligns, false)
+		tableRow := context.parseTableRow(lines[i], starts[i], paragraph.lines, aligns, false)
 		if nil == tableRow {
 			return
 		}
@@ -67,7 +77,7 @@ func (context *Context) parseTable(lines [][]byte) (ret *Table) {
 }
 
 func (context *Context) newTableHead(headRow *TableRow) *TableHead {
-	ret := &TableHead{&BaseNode{typ: NodeTableHead}}
+	ret := &TableHead{&BaseNode{typ: NodeTableHead, pos: headRow.pos}}
 	for c := headRow.FirstChild(); c != nil; {
 		next := c.Next()
 		ret.AppendChild(ret, c)
@@ -76,11 +86,19 @@ func (context *Context) newTableHead(headRow *TableRow) *TableHead {
 	return ret
 }
 
-func (context *Context) parseTableRow(line items, aligns []int, isHead bool) (ret *TableRow) {
-	ret = &TableRow{&BaseNode{typ: NodeTableRow}, aligns}
+// parseTableRow 解析表行 line，index 为 line 在段落 tokens 中的起始下标，lines 为段落的行映射。
+func (context *Context) parseTableRow(line items, index int, lines *lineMap, aligns []int, isHead bool) (ret *TableRow) {
+	index += len(line) - len(bytes.TrimLeftFunc(line, unicode.IsSpace))
+	line = bytes.TrimSpace(line)
+	ret = &TableRow{&BaseNode{typ: NodeTableRow, pos: lines.span(index, index+len(line))}, aligns}
 	cols := line.splitWithoutBackslashEscape(itemPipe)
+	starts := make([]int, len(cols)) // 每列在段落 tokens 中的起始下标
+	for i := range cols {
+		starts[i] = index
+		index += len(cols[i]) + 1
+	}
 	if isBlank(cols[0]) {
-		cols = cols[1:]
+		cols, starts = cols[1:], starts[1:]
 	}
 	if len(cols) > 0 && isBlank(cols[len(cols)-1]) {
 		cols = cols[:len(cols)-1]
@@ -95,8 +113,12 @@ func (context *Context) parseTableRow(line items, aligns []int, isHead bool) (re
 	var i int
 	var col items
 	for ; i < colsLen && i < alignsLen; i++ {
+		start := starts[i] + len(cols[i]) - len(bytes.TrimLeftFunc(cols[i], unicode.IsSpace))
 		col = bytes.TrimSpace(cols[i])
-		cell := &TableCell{&BaseNode{typ: NodeTableCell}, aligns[i]}
+		cell := &TableCell{&BaseNode{typ: NodeTableCell, pos: lines.span(start, start+len(col))}, aligns[i]}
+		cell.lines = &lineMap{}
+		line, column, offset := lines.locate(start)
+		cell.lines.add(0, line, column, offset)
 		col = col.removeFirst(itemBackslash) // 删掉一个反斜杠来恢复语义
 		cell.tokens = col
 		ret.AppendChild(ret, cell)
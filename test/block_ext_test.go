@@ -0,0 +1,194 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+// nodeAdmonition 为提示块节点类型，比如 :::warning。
+var nodeAdmonition = lute.NewNodeType("NodeAdmonition", func(base *lute.BaseNode) lute.Node {
+	return &admonition{base, ""}
+})
+
+// admonition 描述了提示块节点，它是一个可以包含其他块的容器，以 :::kind 开始，以 ::: 结束。
+type admonition struct {
+	*lute.BaseNode
+	kind string
+}
+
+func (a *admonition) Continue(context *lute.Context) int {
+	line := context.CurrentLine()[context.NextNonspace():]
+	if !context.Indented() && bytes.Equal(bytes.TrimSpace(line), []byte(":::")) {
+		context.CloseBlock(a)
+		return 2
+	}
+	return 0
+}
+
+func (a *admonition) CanContain(nodeType int) bool {
+	return lute.NodeListItem != nodeType
+}
+
+var blockExtTests = []parseTest{
+
+	{"5", ":::\n", "<p>:::</p>\n"},
+	{"4", "> :::tip\n> 引用中\n", "<blockquote>\n<div class=\"admonition tip\">\n<p>引用中</p>\n</div>\n</blockquote>\n"},
+	{"3", ":::warning\n未闭合\n", "<div class=\"admonition warning\">\n<p>未闭合</p>\n</div>\n"},
+	{"2", ":::note\n- 列表项\n\n> 引用\n:::\n", "<div class=\"admonition note\">\n<ul>\n<li>列表项</li>\n</ul>\n<blockquote>\n<p>引用</p>\n</blockquote>\n</div>\n"},
+	{"1", ":::tip\n段落 **加粗**\n:::\n外部\n", "<div class=\"admonition tip\">\n<p>段落 <strong>加粗</strong></p>\n</div>\n<p>外部</p>\n"},
+	{"0", ":::warning\n注意\n:::\n", "<div class=\"admonition warning\">\n<p>注意</p>\n</div>\n"},
+}
+
+func newBlockExtLute() *lute.Lute {
+	luteEngine := lute.New()
+	luteEngine.AddBlockExt(&lute.BlockExt{
+		Trigger: ':',
+		Start: func(context *lute.Context, container lute.Node) int {
+			if context.Indented() {
+				return 0
+			}
+			line := context.CurrentLine()[context.NextNonspace():]
+			if !bytes.HasPrefix(line, []byte(":::")) {
+				return 0
+			}
+			kind := strings.TrimSpace(string(line[3:]))
+			if "" == kind {
+				return 0
+			}
+
+			context.CloseUnmatchedBlocks()
+			context.AddChild(&admonition{lute.NewBaseNode(nodeAdmonition), kind})
+			context.AdvanceNextNonspace()
+			context.AdvanceOffset(len(bytes.TrimRight(line, " \t\n")), false)
+			return 1
+		},
+	})
+	luteEngine.SetHTMLRendererFunc(nodeAdmonition, func(r *lute.Renderer, n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering {
+			r.Newline()
+			r.Tag("div", [][]string{{"class", "admonition " + n.(*admonition).kind}}, false)
+			r.Newline()
+		} else {
+			r.Newline()
+			r.WriteString("</div>")
+			r.Newline()
+		}
+		return lute.WalkContinue, nil
+	})
+	return luteEngine
+}
+
+func TestBlockExt(t *testing.T) {
+	luteEngine := newBlockExtLute()
+	for _, test := range blockExtTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestBlockExtJSON(t *testing.T) {
+	luteEngine := newBlockExtLute()
+	tree, err := luteEngine.Parse("", []byte(":::warning\n注意\n:::\n"))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+
+	json, err := luteEngine.RenderJSON(tree)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if !strings.Contains(string(json), "\"type\":\"NodeAdmonition\"") {
+		t.Fatalf("node type name not found in json %s", json)
+	}
+}
+
+func TestBlockExtJSONRoundTrip(t *testing.T) {
+	luteEngine := newBlockExtLute()
+	tree, err := luteEngine.Parse("", []byte(":::warning\n注意\n:::\n"))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+
+	json, err := luteEngine.RenderJSON(tree)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	tree, err = luteEngine.ParseJSON("", json)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if _, ok := tree.Root.FirstChild().(*admonition); !ok {
+		t.Fatalf("unexpected node %T", tree.Root.FirstChild())
+	}
+	html, err := luteEngine.RenderHTML(tree)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if expected := "<div class=\"admonition \">\n<p>注意</p>\n</div>\n"; expected != string(html) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestNewNodeTypeConcurrent(t *testing.T) {
+	luteEngine := newBlockExtLute()
+	tree, err := luteEngine.Parse("", []byte(":::warning\n注意\n:::\n"))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			lute.NewNodeType("", nil)
+		}()
+		go func() {
+			defer wg.Done()
+			json, err := luteEngine.RenderJSON(tree)
+			if nil != err {
+				t.Errorf("unexpected: %s", err)
+				return
+			}
+			if _, err = luteEngine.ParseJSON("", json); nil != err {
+				t.Errorf("unexpected: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewNodeTypeDuplicated(t *testing.T) {
+	for _, name := range []string{"NodeAdmonition", "NodeParagraph"} {
+		func() {
+			defer func() {
+				if nil == recover() {
+					t.Fatalf("duplicated node type name [%s] should panic", name)
+				}
+			}()
+			lute.NewNodeType(name, nil)
+		}()
+	}
+}
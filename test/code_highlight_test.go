@@ -0,0 +1,131 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var codeHighlightTests = []parseTest{
+
+	{"1", "```\nplain\n```\n", "<pre><code class=\"language-fallback\">plain\n</code></pre>\n"},
+	{"0", "```go\nvar a = 1\n```\n", "<pre><code class=\"language-go\"><span class=\"highlight-kd\">var</span> <span class=\"highlight-nx\">a</span> <span class=\"highlight-p\">=</span> <span class=\"highlight-mi\">1</span>\n</code></pre>\n"},
+}
+
+func TestCodeHighlight(t *testing.T) {
+	luteEngine := lute.New()
+
+	for _, test := range codeHighlightTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var codeBlockInfoTests = []parseTest{
+
+	{"6", "```go{2}\na\nb\n```\n", "<pre><code class=\"language-go{2}\">a\nb\n</code></pre>\n"},
+	{"5", "```{r setup}\nx\n```\n", "<pre><code class=\"language-{r\">x\n</code></pre>\n"},
+	{"4", "```go {x,3-1} unknown\na\n```\n", "<pre><code class=\"language-go\"><span class=\"highlight-nx\">a</span>\n</code></pre>\n"},
+	{"3", "```{2}\na\nb\n```\n", "<pre><code class=\"language-fallback\">a\n<span class=\"highlight-hl\">b\n</span></code></pre>\n"},
+	{"2", "```go {1, 3-4} linenostart=9\na\nb\nc\nd\n```\n", "<pre><code class=\"language-go\"><span class=\"highlight-hl\"><span class=\"highlight-ln\">9</span><span class=\"highlight-nx\">a</span>\n</span><span class=\"highlight-ln\">10</span><span class=\"highlight-nx\">b</span>\n<span class=\"highlight-hl\"><span class=\"highlight-ln\">11</span><span class=\"highlight-nx\">c</span>\n</span><span class=\"highlight-hl\"><span class=\"highlight-ln\">12</span><span class=\"highlight-nx\">d</span>\n</span></code></pre>\n"},
+	{"1", "```go linenos\na\nb\n```\n", "<pre><code class=\"language-go\"><span class=\"highlight-ln\">1</span><span class=\"highlight-nx\">a</span>\n<span class=\"highlight-ln\">2</span><span class=\"highlight-nx\">b</span>\n</code></pre>\n"},
+	{"0", "```go {2}\na\nb\n```\n", "<pre><code class=\"language-go\"><span class=\"highlight-nx\">a</span>\n<span class=\"highlight-hl\"><span class=\"highlight-nx\">b</span>\n</span></code></pre>\n"},
+}
+
+func TestCodeBlockInfo(t *testing.T) {
+	luteEngine := lute.New()
+
+	for _, test := range codeBlockInfoTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var codeBlockTitleTests = []parseTest{
+
+	{"4", "```go title=\"a{b} c\" {2}\nx\n```\n", "<figure class=\"code-block\">\n<figcaption>a{b} c</figcaption>\n<pre><code class=\"language-go\">x\n</code></pre>\n</figure>\n"},
+	{"3", "```go title=\"\"\na\n```\n", "<pre><code class=\"language-go\">a\n</code></pre>\n"},
+	{"2", "```title=a<b>.txt\nx\n```\n", "<figure class=\"code-block\">\n<figcaption>a&lt;b&gt;.txt</figcaption>\n<pre><code>x\n</code></pre>\n</figure>\n"},
+	{"1", "```go:main.go\na\n```\n", "<figure class=\"code-block\">\n<figcaption>main.go</figcaption>\n<pre><code class=\"language-go\">a\n</code></pre>\n</figure>\n"},
+	{"0", "```go title=\"main file.go\" linenos\na\n```\n", "<figure class=\"code-block\">\n<figcaption>main file.go</figcaption>\n<pre><code class=\"language-go\">a\n</code></pre>\n</figure>\n"},
+}
+
+func TestCodeBlockTitle(t *testing.T) {
+	luteEngine := lute.New(lute.CodeSyntaxHighlight(false))
+
+	for _, test := range codeBlockTitleTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var codeHighlightClassPrefixTests = []parseTest{
+
+	{"0", "```go\nvar a = 1\n```\n", "<pre><code class=\"language-go\"><span class=\"hl-kd\">var</span> <span class=\"hl-nx\">a</span> <span class=\"hl-p\">=</span> <span class=\"hl-mi\">1</span>\n</code></pre>\n"},
+}
+
+func TestCodeHighlightClassPrefix(t *testing.T) {
+	luteEngine := lute.New(lute.ChromaCodeStyle("monokai"), lute.ChromaCodeClassPrefix("hl-"))
+
+	for _, test := range codeHighlightClassPrefixTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var codeHighlightInlineStyleTests = []parseTest{
+
+	{"1", "    var a = 1\n", "<pre style=\"color:#f8f8f2;background-color:#272822\"><code class=\"language-fallback\">var a = 1\n</code></pre>\n"},
+	{"0", "```go\nvar a = 1\n```\n", "<pre style=\"color:#f8f8f2;background-color:#272822\"><code class=\"language-go\"><span style=\"color:#66d9ef\">var</span> <span style=\"color:#a6e22e\">a</span> = <span style=\"color:#ae81ff\">1</span>\n</code></pre>\n"},
+}
+
+func TestCodeHighlightInlineStyle(t *testing.T) {
+	luteEngine := lute.New(lute.ChromaCodeStyle("monokai"), lute.ChromaCodeInlineStyle(true))
+
+	for _, test := range codeHighlightInlineStyleTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var emojiTests = []parseTest{
+
+	{"8", "中文:smile:。\n", "<p>中文😄。</p>\n"},
+	{"7", ":smile::+1: a:smile: :smile:b\n", "<p>😄👍 a:smile: :smile:b</p>\n"},
+	{"6", "ratio 1:100:1 and std::a::b\n", "<p>ratio 1:100:1 and std::a::b</p>\n"},
+	{"5", "![:smile:](a.png)\n", "<p><img src=\"a.png\" alt=\"😄\" /></p>\n"},
+	{"4", "https://b3log.org/x :heart:\n", "<p><a href=\"https://b3log.org/x\">https://b3log.org/x</a> ❤️</p>\n"},
+	{"3", ":notanemoji: 10:30:00 :smile\n", "<p>:notanemoji: 10:30:00 :smile</p>\n"},
+	{"2", "[:smile:](https://b3log.org/:smile:)\n", "<p><a href=\"https://b3log.org/:smile:\">😄</a></p>\n"},
+	{"1", "`:smile:` :+1:\n", "<p><code>:smile:</code> 👍</p>\n"},
+	{"0", "Hello :smile:\n", "<p>Hello 😄</p>\n"},
+}
+
+func TestEmoji(t *testing.T) {
+	luteEngine := lute.New(lute.Emoji(true))
+
+	for _, test := range emojiTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var customEmojiTests = []parseTest{
+
+	{"4", "![:b3log:](a.png)\n", "<p><img src=\"a.png\" alt=\":b3log:\" /></p>\n"},
+	{"3", ":logo:\n", "<p><img alt=\"logo\" class=\"emoji\" src=\"https://b3log.org/images/logo.png\" title=\"logo\" /></p>\n"},
+	{"2", ":smile:\n", "<p>🙂</p>\n"},
+	{"1", ":ok:\n", "<p>👍</p>\n"},
+	{"0", "欢迎来到 :b3log: 社区\n", "<p>欢迎来到 <img alt=\"b3log\" class=\"emoji\" src=\"https://static.b3log.org/emoji/b3log.png\" title=\"b3log\" /> 社区</p>\n"},
+}
+
+func TestCustomEmoji(t *testing.T) {
+	luteEngine := lute.New(lute.Emoji(true), lute.EmojiSite("https://static.b3log.org/emoji"))
+	luteEngine.PutEmojis(map[string]string{
+		"b3log": "b3log.png",
+		"logo":  "https://b3log.org/images/logo.png",
+		"ok":    ":+1:",
+		"smile": "🙂",
+	})
+
+	for _, test := range customEmojiTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestEmojiDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	// 默认不启用 Emoji
+	html, err := luteEngine.MarkdownStr("", ":smile:\n")
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if expected := "<p>:smile:</p>\n"; expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
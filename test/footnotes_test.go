@@ -0,0 +1,58 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var footnotesTests = []parseTest{
+
+	{"6", "引用[^1]\n\n[^1]: 定义\n", "<p>引用<sup class=\"footnote-ref\"><a href=\"#fn1\" id=\"fnref1\">1</a></sup></p>\n<section class=\"footnotes\">\n<ol>\n<li id=\"fn1\">\n<p>定义 <a href=\"#fnref1\" class=\"footnote-backref\">↩</a></p>\n</li>\n</ol>\n</section>\n"},
+	{"5", "[^a]: 未被引用\n\n正文\n", "<p>正文</p>\n"},
+	{"4", "没有定义[^a]\n", "<p>没有定义[^a]</p>\n"},
+	{"3", "[^Note]\n\n[^note]: 标签不区分大小写\n", "<p><sup class=\"footnote-ref\"><a href=\"#fn1\" id=\"fnref1\">1</a></sup></p>\n<section class=\"footnotes\">\n<ol>\n<li id=\"fn1\">\n<p>标签不区分大小写 <a href=\"#fnref1\" class=\"footnote-backref\">↩</a></p>\n</li>\n</ol>\n</section>\n"},
+	{"2", "a[^1]\n\n[^1]: 第一段\n\n    第二段\n\n    > 引用\n\nb\n", "<p>a<sup class=\"footnote-ref\"><a href=\"#fn1\" id=\"fnref1\">1</a></sup></p>\n<p>b</p>\n<section class=\"footnotes\">\n<ol>\n<li id=\"fn1\">\n<p>第一段</p>\n<p>第二段</p>\n<blockquote>\n<p>引用</p>\n</blockquote>\n<p><a href=\"#fnref1\" class=\"footnote-backref\">↩</a></p>\n</li>\n</ol>\n</section>\n"},
+	{"1", "a[^1] b[^1]\n\n[^1]: *强调*\n", "<p>a<sup class=\"footnote-ref\"><a href=\"#fn1\" id=\"fnref1\">1</a></sup> b<sup class=\"footnote-ref\"><a href=\"#fn1\" id=\"fnref1-2\">1</a></sup></p>\n<section class=\"footnotes\">\n<ol>\n<li id=\"fn1\">\n<p><em>强调</em> <a href=\"#fnref1\" class=\"footnote-backref\">↩</a> <a href=\"#fnref1-2\" class=\"footnote-backref\">↩<sup>2</sup></a></p>\n</li>\n</ol>\n</section>\n"},
+	{"0", "先[^b]后[^a]\n\n[^a]: A\n[^b]: B\n", "<p>先<sup class=\"footnote-ref\"><a href=\"#fn1\" id=\"fnref1\">1</a></sup>后<sup class=\"footnote-ref\"><a href=\"#fn2\" id=\"fnref2\">2</a></sup></p>\n<section class=\"footnotes\">\n<ol>\n<li id=\"fn1\">\n<p>B <a href=\"#fnref1\" class=\"footnote-backref\">↩</a></p>\n</li>\n<li id=\"fn2\">\n<p>A <a href=\"#fnref2\" class=\"footnote-backref\">↩</a></p>\n</li>\n</ol>\n</section>\n"},
+}
+
+func TestFootnotes(t *testing.T) {
+	luteEngine := lute.New(lute.Footnotes(true))
+
+	for _, test := range footnotesTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestFootnotesDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	html, err := luteEngine.MarkdownStr("", "a[^1]\n\n[^1]: /url\n")
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	// 默认不启用脚注，此时 [^1]: /url 是链接引用定义
+	if "<p>a<a href=\"/url\">^1</a></p>\n" != html {
+		t.Fatalf("unexpected html %q", html)
+	}
+}
@@ -0,0 +1,88 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var formatLinkRefDefsSortedTests = []formatTest{
+
+	{"0", "[a][Z] [b][y]\n\n[Z]: /z\n[y]: /y\n", "[a][Z] [b][y]\n\n[y]: /y\n[Z]: /z\n\n"},
+}
+
+func TestFormatLinkRefDefsSorted(t *testing.T) {
+	luteEngine := lute.New(lute.FormatLinkRefDefsSorted(true))
+
+	for _, test := range formatLinkRefDefsSortedTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+var formatInlineLinksToRefsTests = []formatTest{
+
+	{"2", "see [1] and [a](/u)\n", "see [1] and [a][2]\n\n[2]: /u\n\n"},
+	{"1", "https://b3log.org and <https://b3log.org>\n", "[https://b3log.org](https://b3log.org) and [https://b3log.org](https://b3log.org)\n\n"},
+	{"0", "see [a *b*](/x) and [c](/y \"t\") and [d](/x) and [e][1]\n\n[1]: /one\n", "see [a *b*][2] and [c][3] and [d][2] and [e][1]\n\n[1]: /one\n[2]: /x\n[3]: /y \"t\"\n\n"},
+}
+
+func TestFormatInlineLinksToRefs(t *testing.T) {
+	luteEngine := lute.New(lute.FormatInlineLinksToRefs(true))
+
+	for _, test := range formatInlineLinksToRefsTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+func TestFormatEditedRefLink(t *testing.T) {
+	luteEngine := lute.New()
+
+	tree, err := luteEngine.Parse("", []byte("[a][r] [b][r]\n\n[r]: /ref \"T\"\n"))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	// 修改了地址或者标题的引用链接需要输出为内联形式，其他引用链接保持引用形式
+	paragraph := tree.Root.FirstChild()
+	paragraph.FirstChild().(*lute.Link).Destination = []byte("/edited")
+	paragraph.LastChild().(*lute.Link).Title = nil
+	formatted, err := luteEngine.RenderMarkdown(tree)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	expected := "[a](/edited \"T\") [b](/ref)\n\n[r]: /ref \"T\"\n\n"
+	if expected != string(formatted) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, formatted)
+	}
+
+	tree, _ = luteEngine.Parse("", []byte("[a][r] [b][r]\n\n[r]: /ref \"T\"\n"))
+	formatted, _ = luteEngine.RenderMarkdown(tree)
+	if "[a][r] [b][r]\n\n[r]: /ref \"T\"\n\n" != string(formatted) {
+		t.Fatalf("unexpected %q", formatted)
+	}
+}
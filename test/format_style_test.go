@@ -0,0 +1,72 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var formatStyleTests = []formatTest{
+
+	{"10", "1. a\n\n   ## 中文标题\n", "1. a\n\n   中文标题\n   --------\n\n"},
+	{"9", "- # Head\n- a\n  - # Deep\n", "- Head\n  ====\n\n- a\n  - Deep\n    ====\n\n"},
+	{"8", "snake_*case* *a*_b_ *_x_* _*a*b_\n", "snake_*case* _a_*b* *_x_* _*a*b_\n\n"},
+	{"7", "***\n", "* * *\n\n"},
+	{"6", "3. a\n4. b\n5. c\n", "3. a\n3. b\n3. c\n\n"},
+	{"5", "1. a\n2. b\n   * c\n   * d\n", "1. a\n1. b\n   - c\n   - d\n\n"},
+	{"4", "```\n~~~~\n```\n", "~~~~~\n~~~~\n~~~~~\n\n"},
+	{"3", "```go\nfmt.Println()\n```\n", "~~~~go\nfmt.Println()\n~~~~\n\n"},
+	{"2", "# 标题\n\n## Heading\n\n### three\n", "标题\n====\n\nHeading\n-------\n\n### three\n\n"},
+	{"1", "*foo* **bar** a*b*c **中**文\n", "_foo_ __bar__ a*b*c **中**文\n\n"},
+	{"0", "* foo\n* bar\n\n+ baz\n", "- foo\n- bar\n\n* baz\n\n"},
+}
+
+func TestFormatStyle(t *testing.T) {
+	luteEngine := lute.New(lute.FormatBulletMarker('-'), lute.FormatEmphasisMarker('_'), lute.FormatStrongMarker('_'),
+		lute.FormatSetextHeading(true), lute.FormatCodeFence('~', 4), lute.FormatOrderedListAllOne(true), lute.FormatThematicBreak("* * *"))
+
+	for _, test := range formatStyleTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+var formatStyleDefaultTests = []formatTest{
+
+	{"2", "***\n", "---\n\n"},
+	{"1", "~~~\n```\n~~~\n", "````\n```\n````\n\n"},
+	{"0", "+ foo\n+ bar\n", "+ foo\n+ bar\n\n"},
+}
+
+func TestFormatStyleDefault(t *testing.T) {
+	luteEngine := lute.New(lute.FormatThematicBreak("--*"))
+
+	for _, test := range formatStyleDefaultTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
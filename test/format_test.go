@@ -27,11 +27,27 @@ type formatTest struct {
 }
 
 var formatTests = []formatTest{
+	{"35", "[ref][r]\n\n<div>\nhtml\n</div>\n\n[r]: /ref \"T\"\n", "[ref][r]\n\n<div>\nhtml\n</div>\n\n[r]: /ref \"T\"\n\n"},
+	{"34", "* a\n\n  > b\n  > c\n", "* a\n\n  > b\n  > c\n\n"},
+	{"33", "* > a\n  > b\n  >\n  > c\n", "* > a\n  > b\n  >\n  > c\n\n"},
+	{"32", "> * a\n>\n>   b\n>\n> > c\n> d\n", "> * a\n>\n>   b\n>\n> > c\n> > d\n\n"},
+	{"31", "> a\nb\n>\n> ```\n> x\n>\n> y\n> ```\n", "> a\n> b\n>\n> ```\n> x\n>\n> y\n> ```\n\n"},
+	{"30", "转义 \\$a$ 和 $ a$ 以及 $b$\n", "转义 \\$a\\$ 和 \\$ a\\$ 以及 $b$\n\n"},
+	{"29", "![](x.png) [*em* `code`](y \"a \\\"b\\\"\")\n", "![](x.png) [*em* `code`](y \"a \\\"b\\\"\")\n\n"},
+	{"28", "[foo][b] and [Bar][] and [baz] and ![img][b]\n\n[baz]: /baz\n[b]: /b \"T\"\n[bar]: <>\n", "[foo][b] and [Bar][] and [baz] and ![img][b]\n\n[baz]: /baz\n[b]: /b \"T\"\n[bar]: <>\n\n"},
+	{"27", "|名称|说明|\n|:--|:-:|\n|`a\\|b`|中文 English|\n|x||\n", "| 名称   |     说明     |\n| :----- | :----------: |\n| `a\\|b` | 中文 English |\n| x      |              |\n\n"},
+	{"26", "| a | b | c |\n|---|:-|-:|\n| 1 | **22** | 333 |\n", "| a   | b      |   c |\n| --- | :----- | --: |\n| 1   | **22** | 333 |\n\n"},
+	{"25", "```go title=\"main file.go\" {1}\na\n```\n\n```go:main.go\nb\n```\n", "```go title=\"main file.go\" {1}\na\n```\n\n```go:main.go\nb\n```\n\n"},
+	{"24", "Hello :smile: `:+1:`\n", "Hello :smile: `:+1:`\n\n"},
+	{"23", "- 列表\n\n  $$\n  x\n\n  y\n  $$\n", "- 列表\n\n  $$\n  x\n\n  y\n  $$\n\n"},
+	{"22", "公式$a+b  =c$中文\n\n$$\n\\frac{1}{2}  *x*\n\n  y\n$$\n", "公式$a+b  =c$中文\n\n$$\n\\frac{1}{2}  *x*\n\n  y\n$$\n\n"},
+	{"21", "脚注[^1]\n\n[^1]: 第一段\n\n    第二段\n\n    * 列表项\n", "脚注[^1]\n\n[^1]: 第一段\n\n    第二段\n\n    * 列表项\n\n"},
+	{"20", "脚注[^1]\n\n[^1]:   内容\n", "脚注[^1]\n\n[^1]: 内容\n\n"},
 	{"19", "我们**需要Markdown Format**\n", "我们**需要 Markdown Format**\n\n"},
 	{"18", "试下中西文间1自动插入lute空格\n", "试下中西文间 1 自动插入 lute 空格\n\n"},
 	{"17", "* [ ] 项一\n* [X] 项二\n", "* [ ] 项一\n* [X] 项二\n\n"},
-	{"16", "| abc | defghi |\n:-: | -----------:\nbar | baz\n", "|abc|defghi|\n|:---:|---:|\n|bar|baz|\n\n"},
-	{"15", "| abc | def |\n| --- | --- |\n", "|abc|def|\n|---|---|\n\n"},
+	{"16", "| abc | defghi |\n:-: | -----------:\nbar | baz\n", "| abc | defghi |\n| :-: | -----: |\n| bar |    baz |\n\n"},
+	{"15", "| abc | def |\n| --- | --- |\n", "| abc | def |\n| --- | --- |\n\n"},
 	{"14", "~~B3log~~\n", "~~B3log~~\n\n"},
 	{"13", "![B3log 开源](https://b3log.org \"B3log 开源\")\n", "![B3log 开源](https://b3log.org \"B3log 开源\")\n\n"},
 	{"12", "[B3log 开源](https://b3log.org \"B3log 开源\")\n", "[B3log 开源](https://b3log.org \"B3log 开源\")\n\n"},
@@ -50,7 +66,7 @@ var formatTests = []formatTest{
 }
 
 func TestFormat(t *testing.T) {
-	luteEngine := lute.New()
+	luteEngine := lute.New(lute.Footnotes(true), lute.Math(true))
 
 	for _, test := range formatTests {
 		fmt.Println("Test [" + test.name + "]")
@@ -0,0 +1,158 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var frontMatterTests = []parseTest{
+
+	{"5", "---\n---\n正文\n", "<p>正文</p>\n"},
+	{"4", "正文\n---\ntitle: 不在开头\n---\n", "<h2>正文</h2>\n<h2>title: 不在开头</h2>\n"},
+	{"3", " ---\ntitle: 缩进\n---\n", "<hr />\n<h2>title: 缩进</h2>\n"},
+	{"2", "---\ntitle: 未闭合\n", "<hr />\n<p>title: 未闭合</p>\n"},
+	{"1", "+++\ntitle = \"Lute\"\n+++\n\n# 标题\n", "<h1>标题</h1>\n"},
+	{"0", "---\ntitle: Lute\ntags: [markdown]\n---\n\n正文\n", "<p>正文</p>\n"},
+}
+
+func TestFrontMatter(t *testing.T) {
+	luteEngine := lute.New(lute.FrontMatter(true))
+
+	for _, test := range frontMatterTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+// decodeFrontMatter 是测试用的元数据解码函数，只支持 YAML 的 key: value 以及 TOML 的 key = "value" 形式。
+func decodeFrontMatter(format string, data []byte) (ret map[string]interface{}, err error) {
+	ret = map[string]interface{}{}
+	separator := ":"
+	if lute.FrontMatterTOML == format {
+		separator = "="
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		kv := strings.SplitN(line, separator, 2)
+		if 2 != len(kv) || "" == strings.TrimSpace(kv[0]) {
+			return nil, errors.New("invalid line [" + line + "]")
+		}
+		ret[strings.TrimSpace(kv[0])] = strings.Trim(strings.TrimSpace(kv[1]), "\"")
+	}
+	return
+}
+
+func TestTreeFrontMatter(t *testing.T) {
+	luteEngine := lute.New(lute.FrontMatter(true))
+
+	cases := []struct {
+		markdown string
+		format   string
+		data     string
+	}{
+		{"---\ntitle: Lute\nauthor:\n  name: 88250 # 注释\n  site: 'https://ld246.com'\ntags: [markdown, \"go\"]\n---\n正文\n", lute.FrontMatterYAML, `{"author":{"name":88250,"site":"https://ld246.com"},"tags":["markdown","go"],"title":"Lute"}`},
+		{"---\nlinks:\n- name: b3log\n  url: https://b3log.org\n- github\ndraft: false\nratio: 0.5\nnote: ~\n---\n", lute.FrontMatterYAML, `{"draft":false,"links":[{"name":"b3log","url":"https://b3log.org"},"github"],"note":null,"ratio":0.5}`},
+		{"---\nsummary: |\n  第一行\n  第二行\nfolded: >-\n  a\n  b\n---\n", lute.FrontMatterYAML, `{"folded":"a b","summary":"第一行\n第二行\n"}`},
+		{"---\n{\"title\": \"Lute\", \"tags\": [\"json\"]}\n---\n", lute.FrontMatterYAML, `{"tags":["json"],"title":"Lute"}`},
+		{"+++\ntitle = \"Lute\" # 注释\ndate = 2019-12-01T08:00:00Z\ntags = [\n  \"markdown\",\n  'go',\n]\n\n[author]\nname = \"88250\"\nposts = 1_024\n\n[[links]]\nurl = \"https://b3log.org\"\n+++\n", lute.FrontMatterTOML, `{"author":{"name":"88250","posts":1024},"date":"2019-12-01T08:00:00Z","links":[{"url":"https://b3log.org"}],"tags":["markdown","go"],"title":"Lute"}`},
+		{"+++\nabout = \"\"\"\n# 不是注释\n\"\"\"\nsite.name = { title = 'Lute', stars = 1 }\n+++\n", lute.FrontMatterTOML, `{"about":"# 不是注释\n","site":{"name":{"stars":1,"title":"Lute"}}}`},
+		{"没有元数据\n", "", `null`},
+	}
+	for _, c := range cases {
+		tree, err := luteEngine.Parse("", []byte(c.markdown))
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+		if format, _ := tree.FrontMatterRaw(); c.format != format {
+			t.Fatalf("expected format %q, got %q", c.format, format)
+		}
+		frontMatter, err := tree.FrontMatter()
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+		data, _ := json.Marshal(frontMatter)
+		if c.data != string(data) {
+			t.Fatalf("expected %s, got %s", c.data, data)
+		}
+	}
+
+	invalid := []string{
+		"---\ntitle: Lute\n不合法\n---\n",
+		"---\ntitle: \"未闭合\n---\n",
+		"---\n- 不是映射\n---\n",
+		"+++\ntitle = \"Lute\"\ntitle = \"重复\"\n+++\n",
+		"+++\n不合法\n+++\n",
+	}
+	for _, markdown := range invalid {
+		tree, _ := luteEngine.Parse("", []byte(markdown))
+		if _, err := tree.FrontMatter(); nil == err {
+			t.Fatalf("invalid front matter %q should return error", markdown)
+		}
+	}
+}
+
+func TestTreeFrontMatterDecoder(t *testing.T) {
+	luteEngine := lute.New(lute.FrontMatter(true), lute.FrontMatterDecoder(decodeFrontMatter))
+
+	tree, _ := luteEngine.Parse("", []byte("---\ntitle: Lute\nauthor: 88250\n---\n正文\n"))
+	frontMatter, err := tree.FrontMatter()
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	// 测试用的解码函数把值都解码为字符串
+	if data, _ := json.Marshal(frontMatter); `{"author":"88250","title":"Lute"}` != string(data) {
+		t.Fatalf("unexpected front matter %s", data)
+	}
+
+	tree, _ = luteEngine.Parse("", []byte("---\ntitle: Lute\n不合法\n---\n"))
+	if _, err := tree.FrontMatter(); nil == err || !strings.Contains(err.Error(), "不合法") {
+		t.Fatalf("invalid front matter should return error, got %v", err)
+	}
+}
+
+func TestTreeFrontMatterRaw(t *testing.T) {
+	luteEngine := lute.New(lute.FrontMatter(true))
+
+	tree, _ := luteEngine.Parse("", []byte("---\ntitle: [\n---\n"))
+	format, data := tree.FrontMatterRaw()
+	if lute.FrontMatterYAML != format || "title: [\n" != string(data) {
+		t.Fatalf("unexpected front matter [%s] %q", format, data)
+	}
+	if _, err := tree.FrontMatter(); nil == err {
+		t.Fatalf("invalid front matter should return error")
+	}
+}
+
+func TestFrontMatterFormat(t *testing.T) {
+	luteEngine := lute.New(lute.FrontMatter(true))
+
+	markdown := "---\ntitle:   Lute  \n# 注释\ntags: [markdown]\n---\n\n正文\n\n"
+	formatted, err := luteEngine.FormatStr("", markdown)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if markdown != formatted {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", markdown, formatted)
+	}
+}
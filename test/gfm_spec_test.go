@@ -59,6 +59,8 @@ var gfmSpecTests = []parseTest{
 	{"auto email link2", "a.b-c_d@a.b-\n", "<p>a.b-c_d@a.b-</p>\n"},
 	{"auto email link3", "a.b-c_d@a.b_\n", "<p>a.b-c_d@a.b_</p>\n"},
 	{"gfm631", "a.b-c_d@a.b\n\na.b-c_d@a.b.\n\na.b-c_d@a.b-\n\na.b-c_d@a.b_\n", "<p><a href=\"mailto:a.b-c_d@a.b\">a.b-c_d@a.b</a></p>\n<p><a href=\"mailto:a.b-c_d@a.b\">a.b-c_d@a.b</a>.</p>\n<p>a.b-c_d@a.b-</p>\n<p>a.b-c_d@a.b_</p>\n"},
+	{"gfm653", "<strong> <title> <style> <em>\n\n<blockquote>\n  <xmp> is disallowed.  <XMP> is also disallowed.\n</blockquote>\n", "<p><strong> &lt;title> &lt;style> <em></p>\n<blockquote>\n  &lt;xmp> is disallowed.  &lt;XMP> is also disallowed.\n</blockquote>\n"},
+	{"tagfilter0", "<script>alert(1)</script>\n\n<scripts> <textarea/> </Style>\n", "&lt;script>alert(1)&lt;/script>\n<p><scripts> &lt;textarea/> &lt;/Style></p>\n"},
 }
 
 func TestGFMSpec(t *testing.T) {
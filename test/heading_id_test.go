@@ -0,0 +1,82 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var headingIDTests = []parseTest{
+
+	{"9", "# x\n# A {#x}\n", "<h1 id=\"x-1\">x</h1>\n<h1 id=\"x\">A</h1>\n"},
+	{"8", "# A {#x}\n# B {#x}\n# x\n", "<h1 id=\"x\">A</h1>\n<h1 id=\"x-1\">B</h1>\n<h1 id=\"x-2\">x</h1>\n"},
+	{"7", "# 标题 {#a\"b}\n", "<h1 id=\"a&quot;b\">标题</h1>\n"},
+	{"6", "# a{#b}\n", "<h1 id=\"ab\">a{#b}</h1>\n"},
+	{"5", "# !!!\n", "<h1 id=\"heading\">!!!</h1>\n"},
+	{"4", "# Foo 1\n# Foo\n# Foo\n", "<h1 id=\"foo-1\">Foo 1</h1>\n<h1 id=\"foo\">Foo</h1>\n<h1 id=\"foo-2\">Foo</h1>\n"},
+	{"3", "# 自定义 {#custom}\n\n标题\n---\n", "<h1 id=\"custom\">自定义</h1>\n<h2 id=\"标题\">标题</h2>\n"},
+	{"2", "# 标题\n## 标题\n### 标题\n", "<h1 id=\"标题\">标题</h1>\n<h2 id=\"标题-1\">标题</h2>\n<h3 id=\"标题-2\">标题</h3>\n"},
+	{"1", "## Lute 引擎：*快速* `Go` 实现\n", "<h2 id=\"lute-引擎快速-go-实现\">Lute 引擎：<em>快速</em> <code>Go</code> 实现</h2>\n"},
+	{"0", "# Hello World!\n", "<h1 id=\"hello-world\">Hello World!</h1>\n"},
+}
+
+func TestHeadingID(t *testing.T) {
+	luteEngine := lute.New(lute.HeadingID(true))
+
+	for _, test := range headingIDTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestHeadingAnchor(t *testing.T) {
+	luteEngine := lute.New(lute.HeadingID(true), lute.HeadingAnchor(true), lute.HeadingSlugger(func(text string) string {
+		return strings.ToUpper(strings.Replace(text, " ", "_", -1))
+	}))
+
+	html, err := luteEngine.MarkdownStr("", "# Hello World\n# Hello World\n")
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	expected := "<h1 id=\"HELLO_WORLD\"><a class=\"anchor\" href=\"#HELLO_WORLD\" aria-hidden=\"true\">#</a>Hello World</h1>\n<h1 id=\"HELLO_WORLD-1\"><a class=\"anchor\" href=\"#HELLO_WORLD-1\" aria-hidden=\"true\">#</a>Hello World</h1>\n"
+	if expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+
+	// 未启用 HeadingID 时 {#id} 作为普通文本
+	html, _ = lute.New().MarkdownStr("", "# 标题 {#id}\n")
+	if "<h1>标题 {#id}</h1>\n" != html {
+		t.Fatalf("unexpected html %q", html)
+	}
+}
+
+func TestHeadingIDFormat(t *testing.T) {
+	luteEngine := lute.New(lute.HeadingID(true))
+
+	formatted, err := luteEngine.FormatStr("", "标题   {#custom}\n===\n\n## 生成的 id 不输出\n\n##   {#only}\n")
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if "# 标题 {#custom}\n\n## 生成的 id 不输出\n\n## {#only}\n\n" != formatted {
+		t.Fatalf("unexpected formatted %q", formatted)
+	}
+}
@@ -0,0 +1,97 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var (
+	nodeAt   = lute.NewNodeType("NodeAt", nil)   // @用户名
+	nodeMark = lute.NewNodeType("NodeMark", nil) // ==高亮==
+)
+
+var inlineExtTests = []parseTest{
+
+	{"5", "a@b.com\n", "<p><a href=\"mailto:a@b.com\">a@b.com</a></p>\n"},
+	{"4", "=不是高亮=\n", "<p>=不是高亮=</p>\n"},
+	{"3", "==**加粗高亮**==\n", "<p><mark><strong>加粗高亮</strong></mark></p>\n"},
+	{"2", "==高亮== 和 @ 符号\n", "<p><mark>高亮</mark> 和 @ 符号</p>\n"},
+	{"1", "*强调 @Vanessa*\n", "<p><em>强调 <a href=\"/member/Vanessa\">@Vanessa</a></em></p>\n"},
+	{"0", "你好 @88250 。\n", "<p>你好 <a href=\"/member/88250\">@88250</a> 。</p>\n"},
+}
+
+func TestInlineExt(t *testing.T) {
+	luteEngine := lute.New(lute.AutoSpace(false))
+	luteEngine.AddInlineExt(&lute.InlineExt{
+		Trigger: '@',
+		Parse: func(t *lute.Tree, ctx *lute.InlineContext) lute.Node {
+			tokens := ctx.Tokens()
+			start := ctx.Pos()
+			if 0 < start && ' ' != tokens[start-1] {
+				return nil
+			}
+			end := start + 1
+			for ; end < len(tokens); end++ {
+				if c := tokens[end]; !('a' <= c && 'z' >= c || 'A' <= c && 'Z' >= c || '0' <= c && '9' >= c) {
+					break
+				}
+			}
+			if start+1 == end {
+				return nil
+			}
+
+			ctx.SetPos(end)
+			ret := lute.NewBaseNode(nodeAt)
+			ret.SetTokens(tokens[start+1 : end])
+			return ret
+		},
+	})
+	luteEngine.AddInlineExt(&lute.InlineExt{
+		Trigger: '=',
+		Delim: func(num int) lute.Node {
+			if 2 != num {
+				return nil
+			}
+			return lute.NewBaseNode(nodeMark)
+		},
+	})
+	luteEngine.SetHTMLRendererFunc(nodeAt, func(r *lute.Renderer, n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering {
+			name := string(n.Tokens())
+			r.WriteString("<a href=\"/member/" + name + "\">@" + name + "</a>")
+		}
+		return lute.WalkContinue, nil
+	})
+	luteEngine.SetHTMLRendererFunc(nodeMark, func(r *lute.Renderer, n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering {
+			r.WriteString("<mark>")
+		} else {
+			r.WriteString("</mark>")
+		}
+		return lute.WalkContinue, nil
+	})
+
+	for _, test := range inlineExtTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
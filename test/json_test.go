@@ -0,0 +1,109 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+func TestJSON(t *testing.T) {
+	luteEngine := lute.New()
+
+	markdown := "# 标题\n\n- [x] 任务\n\n1) 列表\n\n| a | b |\n|:--|--:|\n| `c` | [d](/e \"f\") |\n\n```go\ncode\n```\n"
+	tree, err := luteEngine.Parse("", []byte(markdown))
+	if nil != err {
+		t.Fatalf("parse failed: %s", err)
+	}
+
+	data, err := luteEngine.RenderJSON(tree)
+	if nil != err {
+		t.Fatalf("render json failed: %s", err)
+	}
+	var root map[string]interface{}
+	if err = json.Unmarshal(data, &root); nil != err {
+		t.Fatalf("invalid json [%s]: %s", data, err)
+	}
+	if "NodeDocument" != root["type"] {
+		t.Fatalf("unexpected root node type [%v]", root["type"])
+	}
+	heading := root["children"].([]interface{})[0].(map[string]interface{})
+	if "NodeHeading" != heading["type"] || 1.0 != heading["level"] {
+		t.Fatalf("unexpected heading node %v", heading)
+	}
+
+	testJSONRoundTrip(t, luteEngine, "json", markdown)
+	testJSONRoundTrip(t, luteEngine, "json link ref", "[a][B] [c][] [d] [e](/f \"\") ![g][b]\n\n[b]: /url 'title'\n[c]: </c>\n[d]: /d\n")
+}
+
+func TestJSONSpec(t *testing.T) {
+	bytes, err := ioutil.ReadFile("commonmark-spec.json")
+	if nil != err {
+		t.Fatalf("read spec test cases failed: " + err.Error())
+	}
+
+	var testcases []testcase
+	if err = json.Unmarshal(bytes, &testcases); nil != err {
+		t.Fatalf("read spec test caes failed: " + err.Error())
+	}
+
+	luteEngine := lute.New(lute.SoftBreak2HardBreak(false), lute.CodeSyntaxHighlight(false), lute.AutoSpace(false))
+	for _, test := range testcases {
+		testJSONRoundTrip(t, luteEngine, test.Section+" "+strconv.Itoa(test.Example), test.Markdown)
+	}
+	for _, test := range gfmSpecTests {
+		testJSONRoundTrip(t, luteEngine, test.name, test.markdown)
+	}
+}
+
+// testJSONRoundTrip 测试 markdown 的语法树渲染为 JSON 后再还原为语法树，渲染得到的 HTML 和格式化结果保持不变。
+func testJSONRoundTrip(t *testing.T, luteEngine *lute.Lute, name, markdown string) {
+	tree, err := luteEngine.Parse(name, []byte(markdown))
+	if nil != err {
+		t.Fatalf("parse failed: %s", err)
+	}
+	expected, err := luteEngine.RenderHTML(tree)
+	if nil != err {
+		t.Fatalf("render html failed: %s", err)
+	}
+	expectedFormatted, err := luteEngine.RenderMarkdown(tree)
+	if nil != err {
+		t.Fatalf("render markdown failed: %s", err)
+	}
+	data, err := luteEngine.RenderJSON(tree)
+	if nil != err {
+		t.Fatalf("render json failed: %s", err)
+	}
+	tree, err = luteEngine.ParseJSON(name, data)
+	if nil != err {
+		t.Fatalf("test case [%s] parse json failed: %s\n\t%s", name, err, data)
+	}
+	html, err := luteEngine.RenderHTML(tree)
+	if nil != err {
+		t.Fatalf("render html failed: %s", err)
+	}
+	if string(expected) != string(html) {
+		t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\njson\n\t%s", name, expected, html, data)
+	}
+	formatted, err := luteEngine.RenderMarkdown(tree)
+	if nil != err {
+		t.Fatalf("render markdown failed: %s", err)
+	}
+	if string(expectedFormatted) != string(formatted) {
+		t.Fatalf("test case [%s] format failed\nexpected\n\t%q\ngot\n\t%q\njson\n\t%s", name, expectedFormatted, formatted, data)
+	}
+}
@@ -0,0 +1,136 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var losslessTests = []formatTest{
+
+	{"6", "Foo\r\n===\r\n\r\n* a\r\n* b\r\n", "Foo\r\n===\r\n\r\n* a\r\n* b\r\n"},
+	{"5", "  *  a   \\*b\\*\n\n  *  c\n", "  *  a   \\*b\\*\n\n  *  c\n"},
+	{"4", "> foo\n>bar\n\n    code\n", "> foo\n>bar\n\n    code\n"},
+	{"3", "[a][B]  [c]\n\n[b]:   /url  'title'\n[C]: </c>\n", "[a][B]  [c]\n\n[b]:   /url  'title'\n[C]: </c>\n"},
+	{"2", "| a | b |\n|---|:-:|\n|  | 2 |\n", "| a | b |\n|---|:-:|\n|  | 2 |\n"},
+	{"1", "__foo__ _bar_ ~~baz~~  \nqux\\\n<b>x</b>\n", "__foo__ _bar_ ~~baz~~  \nqux\\\n<b>x</b>\n"},
+	{"0", "", ""},
+}
+
+func TestLossless(t *testing.T) {
+	luteEngine := lute.New(lute.Lossless(true))
+
+	for _, test := range losslessTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+func TestLosslessFiles(t *testing.T) {
+	luteEngine := lute.New(lute.Lossless(true))
+
+	for _, name := range []string{"commonmark-spec.md", "case1.md", "format-case1.md"} {
+		original, err := ioutil.ReadFile(name)
+		if nil != err {
+			t.Fatalf("read file [%s] failed: %s", name, err)
+		}
+
+		formatted, err := luteEngine.Format(name, original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+		if string(original) != string(formatted) {
+			t.Fatalf("lossless format file [%s] failed", name)
+		}
+	}
+}
+
+var losslessEditTests = []struct {
+	name      string
+	original  string
+	edit      func(tree *lute.Tree)
+	formatted string
+}{
+
+	{"9", "x\n\ntext\n\n    code\n\n# h\n\n  para\n", func(tree *lute.Tree) {
+		tree.Root.FirstChild().Next().Unlink()
+		tree.Root.LastChild().Previous().Unlink()
+	}, "x\n\n    code\n\n  para\n"},
+	{"8", "text\n\n    code\n", func(tree *lute.Tree) {
+		tree.Root.FirstChild().Unlink()
+	}, "    code\n"},
+	{"7", "#  a\n\n>  b\n>\n>  c\n", func(tree *lute.Tree) {
+		blockquote := tree.Root.LastChild()
+		blockquote.FirstChild().InsertBefore(blockquote.FirstChild(), paragraph("new"))
+	}, "#  a\n\n> new\n>\n> b\n>\n> c\n"},
+	{"6", "> 1.  foo\n>\n>     bar\n", func(tree *lute.Tree) {
+		listItem := tree.Root.FirstChild().FirstChild().FirstChild()
+		listItem.FirstChild().InsertBefore(listItem.FirstChild(), paragraph("new"))
+	}, "> 1. new\n>\n>     foo\n>\n>     bar\n"},
+	{"5", "Title\n=====\n\n* a\n*  b\n", func(tree *lute.Tree) {
+		tree.Root.FirstChild().(*lute.Heading).Level = 2
+	}, "## Title\n\n* a\n*  b\n"},
+	{"4", "foo  *bar*\n\n[baz]:  /url\n", func(tree *lute.Tree) {
+		tree.Root.AppendChild(tree.Root, paragraph("new  *para*"))
+	}, "foo  *bar*\n\n[baz]:  /url\n\nnew  *para*\n"},
+	{"3", "# a\n\n__b__\n\n---\n", func(tree *lute.Tree) {
+		tree.Root.FirstChild().Next().Unlink()
+	}, "# a\n\n---\n"},
+	{"2", "see  [a](/x  'y')  ok\n", func(tree *lute.Tree) {
+		tree.Root.FirstChild().FirstChild().Next().(*lute.Link).Destination = []byte("/z")
+	}, "see  [a](/z \"y\")  ok\n"},
+	{"1", ">  * foo\n>    bar\n>  * __baz__\n", func(tree *lute.Tree) {
+		text := tree.Root.FirstChild().FirstChild().FirstChild().FirstChild().FirstChild()
+		text.SetTokens([]byte("qux"))
+	}, ">  * qux\n>    bar\n>  * __baz__\n"},
+	{"0", "_foo_  \\*bar\\*\n\n> quote\n", func(tree *lute.Tree) {
+		tree.Root.LastChild().InsertBefore(tree.Root.LastChild(), paragraph("line1\nline2"))
+	}, "_foo_  \\*bar\\*\n\nline1\nline2\n\n> quote\n"},
+}
+
+func TestLosslessEdit(t *testing.T) {
+	luteEngine := lute.New(lute.Lossless(true))
+
+	for _, test := range losslessEditTests {
+		tree, err := luteEngine.Parse(test.name, []byte(test.original))
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		test.edit(tree)
+		formatted, err := luteEngine.RenderMarkdown(tree)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+		if test.formatted != string(formatted) {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+// paragraph 解析 markdown 并返回其中的第一个段落节点，用于构造插入到其他语法树中的节点。
+func paragraph(markdown string) lute.Node {
+	tree, _ := lute.New().Parse("", []byte(markdown))
+	ret := tree.Root.FirstChild()
+	ret.Unlink()
+	return ret
+}
@@ -0,0 +1,88 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var mathTests = []parseTest{
+
+	{"6", "$$\n未闭合\n", "<div class=\"language-math\">未闭合\n</div>\n"},
+	{"5", "- 列表\n\n  $$\n  x\n  $$\n", "<ul>\n<li>\n<p>列表</p>\n<div class=\"language-math\">x\n</div>\n</li>\n</ul>\n"},
+	{"4", "$$\na < b\n\n*c*\n$$\n", "<div class=\"language-math\">a &lt; b\n\n*c*\n</div>\n"},
+	{"3", "转义 \\$a$ 和 $ a$\n", "<p>转义 $a$ 和 $ a$</p>\n"},
+	{"2", "价格$5和$10\n", "<p>价格 $5 和 $10</p>\n"},
+	{"1", "$a_1 *b*$\n", "<p><span class=\"language-math\">a_1 *b*</span></p>\n"},
+	{"0", "公式$E=mc^2$中文English\n", "<p>公式<span class=\"language-math\">E=mc^2</span>中文 English</p>\n"},
+}
+
+func TestMath(t *testing.T) {
+	luteEngine := lute.New(lute.Math(true))
+
+	for _, test := range mathTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var mathConverterTests = []parseTest{
+
+	{"1", "$$\nerror\n$$\n", "<div class=\"language-math\">error\n</div>\n"},
+	{"0", "行级$x$\n\n$$\ny\n$$\n", "<p>行级<span class=\"katex\">x</span></p>\n<div class=\"katex-display\">y\n</div>\n"},
+}
+
+func TestMathConverter(t *testing.T) {
+	luteEngine := lute.New(lute.Math(true), lute.MathConverter(func(tex []byte, displayMode bool) ([]byte, error) {
+		if "error\n" == string(tex) {
+			return nil, errors.New("invalid tex")
+		}
+		if displayMode {
+			return []byte("<div class=\"katex-display\">" + string(tex) + "</div>"), nil
+		}
+		return []byte("<span class=\"katex\">" + string(tex) + "</span>"), nil
+	}))
+
+	for _, test := range mathConverterTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestMathDisabled(t *testing.T) {
+	luteEngine := lute.New()
+
+	// 默认不启用数学公式
+	html, err := luteEngine.MarkdownStr("", "价格$5和$10\n")
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if "<p>价格 $5 和 $10</p>\n" != html {
+		t.Fatalf("unexpected html %q", html)
+	}
+}
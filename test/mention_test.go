@@ -0,0 +1,94 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var mentionTests = []parseTest{
+
+	{"11", "![*@88250* #tag](a.png)\n", "<p><img src=\"a.png\" alt=\"@88250 #tag\" /></p>\n"},
+	{"10", "[**@bar** _#tag_](http://x)\n", "<p><a href=\"http://x\"><strong>@bar</strong> <em>#tag</em></a></p>\n"},
+	{"9", "[@88250](https://b3log.org) `@Vanessa`\n", "<p><a href=\"https://b3log.org\">@88250</a> <code>@Vanessa</code></p>\n"},
+	{"8", "#123 #123# C# ##\n", "<p>#123 #123# C# ##</p>\n"},
+	{"7", "我喜欢#开源#软件，#Go语言 很好\n", "<p>我喜欢<a href=\"/tags/开源\" class=\"hashtag\">#开源#</a>软件，<a href=\"/tags/Go语言\" class=\"hashtag\">#Go语言</a> 很好</p>\n"},
+	{"6", "#lute, #b3log-\n", "<p><a href=\"/tags/lute\" class=\"hashtag\">#lute</a>, <a href=\"/tags/b3log\" class=\"hashtag\">#b3log</a>-</p>\n"},
+	{"5", "@example.com @a@b\n", "<p>@example.com @a@b</p>\n"},
+	{"4", "联系 88250@b3log.org\n", "<p>联系 <a href=\"mailto:88250@b3log.org\">88250@b3log.org</a></p>\n"},
+	{"3", "@nobody 你好\n", "<p>@nobody 你好</p>\n"},
+	{"2", "(@88250) **@Vanessa**\n", "<p>(<a href=\"/member/88250\" class=\"mention\">@88250</a>) <strong><a href=\"/member/Vanessa\" class=\"mention\">@Vanessa</a></strong></p>\n"},
+	{"1", "你好@88250。\n", "<p>你好<a href=\"/member/88250\" class=\"mention\">@88250</a>。</p>\n"},
+	{"0", "@88250 @Vanessa-\n", "<p><a href=\"/member/88250\" class=\"mention\">@88250</a> <a href=\"/member/Vanessa\" class=\"mention\">@Vanessa</a>-</p>\n"},
+}
+
+func TestMention(t *testing.T) {
+	luteEngine := lute.New(lute.Mentions(true), lute.Hashtags(true),
+		lute.MentionResolver(func(name string) []byte {
+			if "nobody" == name {
+				return nil
+			}
+			return []byte("/member/" + name)
+		}),
+		lute.HashtagResolver(func(name string) []byte {
+			return []byte("/tags/" + name)
+		}))
+
+	for _, test := range mentionTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestMentionsAndHashtags(t *testing.T) {
+	luteEngine := lute.New(lute.Mentions(true), lute.Hashtags(true))
+
+	markdown := "@88250 提到了 @Vanessa 和 @88250\n\n* #开源# #lute\n\n`@ignored #ignored`\n"
+	tree, err := luteEngine.Parse("", []byte(markdown))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+
+	if mentions := tree.Mentions(); !reflect.DeepEqual([]string{"88250", "Vanessa"}, mentions) {
+		t.Fatalf("unexpected mentions: %q", mentions)
+	}
+	if hashtags := tree.Hashtags(); !reflect.DeepEqual([]string{"开源", "lute"}, hashtags) {
+		t.Fatalf("unexpected hashtags: %q", hashtags)
+	}
+
+	// 没有设置解析函数时按普通文本渲染
+	html, err := luteEngine.RenderHTML(tree)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if expected := "<p>@88250 提到了 @Vanessa 和 @88250</p>\n<ul>\n<li>#开源# #lute</li>\n</ul>\n<p><code>@ignored #ignored</code></p>\n"; expected != string(html) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+
+	formatted, err := luteEngine.RenderMarkdown(tree)
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if expected := "@88250 提到了 @Vanessa 和 @88250\n\n* #开源# #lute\n\n`@ignored #ignored`\n\n"; expected != string(formatted) {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, formatted)
+	}
+}
@@ -0,0 +1,87 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+type positionTest struct {
+	name      string
+	markdown  string
+	positions string // 按遍历顺序列出的节点位置（不包括根节点）
+}
+
+var positionTests = []positionTest{
+	{"9", "a\r\nb\r\n\r\n# c\r\n", "1:1-2:1 1:1-1:1 1:2-1:2 2:1-2:1 4:1-4:3 4:3-4:3"},
+	{"8", "[l][x] <http://a.com> www.b3log.org\n\n[x]: /u\n", "1:1-1:35 1:1-1:6 1:2-1:2 1:7-1:7 1:8-1:21 1:9-1:20 1:22-1:22 1:23-1:35 1:23-1:35"},
+	{"7", "| a | b |\n|---|---|\n| 1 | `2` |\n", "1:1-3:11 1:1-1:9 1:3-1:3 1:3-1:3 1:7-1:7 1:7-1:7 3:1-3:11 3:3-3:3 3:3-3:3 3:7-3:9 3:7-3:9"},
+	{"6", "- [x] item\n- b\n\n  c\n", "1:1-1:10 1:1-1:10 1:3-1:5 1:3-1:10 1:6-1:10 2:1-4:3 2:1-4:3 2:3-2:3 2:3-2:3 4:3-4:3 4:3-4:3"},
+	{"5", "```go\ncode\n```\n", "1:1-3:3"},
+	{"4", "> foo **bar**\n> baz\n", "1:1-2:5 1:3-2:5 1:3-1:6 1:7-1:13 1:9-1:11 1:14-1:14 2:3-2:5"},
+	{"3", "Setext\n===\n", "1:1-2:3 1:1-1:6"},
+	{"2", "# 标题 *a*\n", "1:1-1:12 1:3-1:9 1:10-1:12 1:11-1:11"},
+	{"1", "[x]: /u\nfoo\n", "2:1-2:3 2:1-2:3"},
+	{"0", "foo\n", "1:1-1:3 1:1-1:3"},
+}
+
+func TestPosition(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.AutoSpace = false
+
+	for _, test := range positionTests {
+		tree, err := luteEngine.Parse("", []byte(test.markdown))
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		var positions []string
+		lute.Walk(tree.Root, func(n lute.Node, entering bool) (lute.WalkStatus, error) {
+			if entering && lute.NodeDocument != n.Type() {
+				pos := n.Position()
+				positions = append(positions, fmt.Sprintf("%d:%d-%d:%d", pos.StartLine, pos.StartColumn, pos.EndLine, pos.EndColumn))
+			}
+			return lute.WalkContinue, nil
+		})
+		if got := strings.Join(positions, " "); test.positions != got {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.positions, got, test.markdown)
+		}
+	}
+}
+
+func TestPositionOffset(t *testing.T) {
+	luteEngine := lute.New()
+
+	markdown := "段落\r\n\r\n*强调*和`代码`\r\n"
+	tree, err := luteEngine.Parse("", []byte(markdown))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+
+	var sources []string
+	lute.Walk(tree.Root, func(n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering && lute.NodeDocument != n.Type() {
+			pos := n.Position()
+			sources = append(sources, markdown[pos.StartOffset:pos.EndOffset])
+		}
+		return lute.WalkContinue, nil
+	})
+	expected := "段落|段落|*强调*和`代码`|*强调*|强调|和|`代码`"
+	if got := strings.Join(sources, "|"); expected != got {
+		t.Fatalf("offset test failed\nexpected\n\t%q\ngot\n\t%q", expected, got)
+	}
+}
@@ -0,0 +1,90 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var proseWrapAlwaysTests = []formatTest{
+
+	{"7", "- > quoted text that goes on and on\n", "- > quoted text that\n  > goes on and on\n\n"},
+	{"6", "hard  \nbreak\n", "hard\\\nbreak\n\n"},
+	{"5", "value is 1. and - x # y > z\n", "value is 1. and - x #\ny > z\n\n"},
+	{"4", "> quoted text that goes on and on and on\n", "> quoted text that\n> goes on and on and\n> on\n\n"},
+	{"3", "- list item with a long long text inside\n- b\n", "- list item with a\n  long long text\n  inside\n- b\n\n"},
+	{"2", "see `a long code span` and <span>x</span> here\n", "see\n`a long code span`\nand <span>x</span>\nhere\n\n"},
+	{"1", "中文段落需要按照宽度进行重新排版，标点符号不能出现在行首。\n第二行。\n", "中文段落需要按照宽度\n进行重新排版，标点符\n号不能出现在行首。第\n二行。\n\n"},
+	{"0", "The quick brown fox jumps over the lazy dog. It was fun! Then\nthe end.\n", "The quick brown fox\njumps over the lazy\ndog. It was fun!\nThen the end.\n\n"},
+}
+
+func TestProseWrapAlways(t *testing.T) {
+	luteEngine := lute.New(lute.FormatProseWrap(lute.ProseWrapAlways, 20), lute.SoftBreak2HardBreak(false))
+
+	for _, test := range proseWrapAlwaysTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+var proseWrapNeverTests = []formatTest{
+
+	{"2", "> quoted\n> text\n", "> quoted text\n\n"},
+	{"1", "中文\n段落\nand\n中文\n", "中文段落 and 中文\n\n"},
+	{"0", "The quick\nbrown fox.\n", "The quick brown fox.\n\n"},
+}
+
+func TestProseWrapNever(t *testing.T) {
+	luteEngine := lute.New(lute.FormatProseWrap(lute.ProseWrapNever, 0), lute.SoftBreak2HardBreak(false))
+
+	for _, test := range proseWrapNeverTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
+
+var proseWrapSentenceTests = []formatTest{
+
+	{"2", "Version 1. 2. 3 and J. Doe.\n", "Version 1. 2.\n3 and J. Doe.\n\n"},
+	{"1", "中文句子。第二句！\n还是第二句？\n", "中文句子。\n第二句！\n还是第二句？\n\n"},
+	{"0", "The quick brown fox jumps over the lazy dog. It was fun! Then\nthe end.\n", "The quick brown fox jumps over the lazy dog.\nIt was fun!\nThen the end.\n\n"},
+}
+
+func TestProseWrapSentence(t *testing.T) {
+	luteEngine := lute.New(lute.FormatProseWrap(lute.ProseWrapSentence, 0), lute.SoftBreak2HardBreak(false))
+
+	for _, test := range proseWrapSentenceTests {
+		formatted, err := luteEngine.FormatStr(test.name, test.original)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.formatted != formatted {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.formatted, formatted, test.original)
+		}
+	}
+}
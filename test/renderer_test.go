@@ -0,0 +1,92 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var rendererFuncTests = []parseTest{
+
+	{"2", "[链接](https://b3log.org)\n", "<p><span class=\"link\"><a href=\"https://b3log.org\">链接</a></span></p>\n"},
+	{"1", "![图片](/a.png \"标题\")\n", "<p><img src=\"https://cdn.b3log.org/a.png\" alt=\"图片\" loading=\"lazy\" /></p>\n"},
+	{"0", "**加粗**\n", "<p><strong>加粗</strong></p>\n"},
+}
+
+func TestHTMLRendererFunc(t *testing.T) {
+	luteEngine := lute.New()
+
+	// 图片使用 CDN 地址并启用懒加载
+	luteEngine.SetHTMLRendererFunc(lute.NodeImage, func(r *lute.Renderer, n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering {
+			img := n.(*lute.Image)
+			src := string(img.Destination)
+			if strings.HasPrefix(src, "/") {
+				src = "https://cdn.b3log.org" + src
+			}
+			r.Tag("img", [][]string{{"src", src}, {"alt", string(img.FirstChild().Tokens())}, {"loading", "lazy"}}, true)
+		}
+		return lute.WalkSkipChildren, nil
+	})
+	// 链接在内置渲染结果外包裹一层
+	luteEngine.SetHTMLRendererFunc(lute.NodeLink, func(r *lute.Renderer, n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering {
+			r.WriteString("<span class=\"link\">")
+			return r.RenderDefault(n, entering)
+		}
+		status, err := r.RenderDefault(n, entering)
+		r.WriteString("</span>")
+		return status, err
+	})
+
+	for _, test := range rendererFuncTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+
+	// 恢复使用内置渲染函数
+	luteEngine.SetHTMLRendererFunc(lute.NodeImage, nil)
+	html, _ := luteEngine.MarkdownStr("", "![图片](/a.png)\n")
+	if "<p><img src=\"/a.png\" alt=\"图片\" /></p>\n" != html {
+		t.Fatalf("reset renderer func failed, got %q", html)
+	}
+}
+
+func TestFormatRendererFunc(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.SetFormatRendererFunc(lute.NodeThematicBreak, func(r *lute.Renderer, n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering {
+			r.Newline()
+			r.WriteString("***")
+			r.Newline()
+		}
+		return lute.WalkContinue, nil
+	})
+
+	formatted, err := luteEngine.FormatStr("", "分隔线\n\n---\n")
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+	if "分隔线\n\n***\n" != formatted {
+		t.Fatalf("format renderer func failed, got %q", formatted)
+	}
+}
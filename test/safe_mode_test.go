@@ -0,0 +1,95 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var safeModeTests = []parseTest{
+
+	{"10", "<div>\n<!-- 注释 --><![CDATA[x]]>a < b\n</div>\n", "<div>\na &lt; b\n</div>\n"},
+	{"9", "<style>p { color: red }</style>\n\n正文\n", "<p>正文</p>\n"},
+	{"8", "<script>alert(1)</SCRIPT>\n", ""},
+	{"7", "<img src=x onerror=alert(1)>\n", "<img src=\"x\">\n"},
+	{"6", "<a href=\"&#106;avascript:alert(1)\" title='a\"b' onclick=\"x\">链接</a>\n", "<a title=\"a&quot;b\">链接</a>\n"},
+	{"5", "<details open><summary>摘要</summary>内容</details>\n", "<details open=\"\"><summary>摘要</summary>内容</details>\n"},
+	{"4", "![图片](data:image/png;base64,AAAA) ![图片](data:text/html;base64,AAAA)\n", "<p><img src=\"data:image/png;base64,AAAA\" alt=\"图片\" /> <img src=\"\" alt=\"图片\" /></p>\n"},
+	{"3", "[链接](JavaScript:alert(1)) [链接](vbscript:x) [链接](file:///etc/passwd)\n", "<p><a href=\"\">链接</a> <a href=\"\">链接</a> <a href=\"\">链接</a></p>\n"},
+	{"2", "[链接](https://b3log.org/?a=javascript:x) [链接](/a:b)\n", "<p><a href=\"https://b3log.org/?a=javascript:x\">链接</a> <a href=\"/a:b\">链接</a></p>\n"},
+	{"1", "foo <iframe src=\"https://b3log.org\"></iframe> bar\n", "<p>foo  bar</p>\n"},
+	{"0", "<p align=\"center\" class=\"x\">段落 <em>强调</em></p>\n", "<p>段落 <em>强调</em></p>\n"},
+}
+
+func TestSafeMode(t *testing.T) {
+	luteEngine := lute.New(lute.SafeMode(true))
+
+	for _, test := range safeModeTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var htmlAllowlistTests = []parseTest{
+
+	{"1", "<div class=\"x\">a</div>\n", "a\n"},
+	{"0", "<p align=\"center\" class=\"x\">段落 <span id=\"y\" class=\"z\">a</span></p>\n", "<p align=\"center\" class=\"x\">段落 <span class=\"z\">a</span></p>\n"},
+}
+
+func TestHTMLAllowlist(t *testing.T) {
+	allowlist := lute.DefaultHTMLAllowlist()
+	allowlist["p"] = append(allowlist["p"], "align")
+	allowlist["*"] = []string{"class"}
+	delete(allowlist, "div")
+	luteEngine := lute.New(lute.SafeMode(true), lute.HTMLAllowlist(allowlist))
+
+	for _, test := range htmlAllowlistTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+var escapeRawHTMLTests = []parseTest{
+
+	{"1", "a <b onclick=\"x\">b</b>\n", "<p>a &lt;b onclick=&quot;x&quot;&gt;b&lt;/b&gt;</p>\n"},
+	{"0", "<script>alert(1)</script>\n", "&lt;script&gt;alert(1)&lt;/script&gt;\n"},
+}
+
+func TestEscapeRawHTML(t *testing.T) {
+	luteEngine := lute.New(lute.EscapeRawHTML(true))
+
+	for _, test := range escapeRawHTMLTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
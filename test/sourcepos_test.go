@@ -0,0 +1,44 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var sourcePosTests = []parseTest{
+
+	{"5", "| a |\n| --- |\n| b |\n", "<table data-sourcepos=\"1:1-3:5\">\n<thead>\n<tr>\n<th>a</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>b</td>\n</tr>\n</tbody>\n</table>\n"},
+	{"4", "```\ncode\n```\n", "<pre data-sourcepos=\"1:1-3:3\"><code>code\n</code></pre>\n"},
+	{"3", "> foo\n\n***\n", "<blockquote data-sourcepos=\"1:1-1:5\">\n<p data-sourcepos=\"1:3-1:5\">foo</p>\n</blockquote>\n<hr data-sourcepos=\"3:1-3:3\" />\n"},
+	{"2", "2. foo\n3. bar\n", "<ol start=\"2\" data-sourcepos=\"1:1-2:6\">\n<li data-sourcepos=\"1:1-1:6\">foo</li>\n<li data-sourcepos=\"2:1-2:6\">bar</li>\n</ol>\n"},
+	{"1", "标题\n---\n", "<h2 data-sourcepos=\"1:1-2:3\">标题</h2>\n"},
+	{"0", "# foo\n\nbar\nbaz\n", "<h1 data-sourcepos=\"1:1-1:5\">foo</h1>\n<p data-sourcepos=\"3:1-4:3\">bar\nbaz</p>\n"},
+}
+
+func TestSourcePos(t *testing.T) {
+	luteEngine := lute.New(lute.SourcePos(true), lute.CodeSyntaxHighlight(false), lute.SoftBreak2HardBreak(false))
+
+	for _, test := range sourcePosTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var tocTests = []parseTest{
+
+	{"4", "[toc]\n\n# A {#x}\n", "<div class=\"toc\">\n<ul>\n<li><a href=\"#x\">A</a></li>\n</ul>\n</div>\n<h1 id=\"x\">A</h1>\n"},
+	{"3", "[toc]\n\n正文\n", "<p>正文</p>\n"},
+	{"2", "[TOC]\n\n# A & B\n", "<div class=\"toc\">\n<ul>\n<li><a href=\"#a--b\">A &amp; B</a></li>\n</ul>\n</div>\n<h1 id=\"a--b\">A &amp; B</h1>\n"},
+	{"1", "[toc] 不是占位\n\n# 标题\n", "<p>[toc] 不是占位</p>\n<h1 id=\"标题\">标题</h1>\n"},
+	{"0", "[toc]\n\n# 一\n## 一.1\n### 一.1.1\n## 一.2\n# 二\n", "<div class=\"toc\">\n<ul>\n<li><a href=\"#一\">一</a>\n<ul>\n<li><a href=\"#一1\">一.1</a>\n<ul>\n<li><a href=\"#一11\">一.1.1</a></li>\n</ul>\n</li>\n<li><a href=\"#一2\">一.2</a></li>\n</ul>\n</li>\n<li><a href=\"#二\">二</a></li>\n</ul>\n</div>\n<h1 id=\"一\">一</h1>\n<h2 id=\"一1\">一.1</h2>\n<h3 id=\"一11\">一.1.1</h3>\n<h2 id=\"一2\">一.2</h2>\n<h1 id=\"二\">二</h1>\n"},
+}
+
+func TestTOCPlaceholder(t *testing.T) {
+	luteEngine := lute.New(lute.TOC(true))
+
+	for _, test := range tocTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+
+	formatted, _ := luteEngine.FormatStr("", "[Toc]\n# 标题\n")
+	if "[toc]\n\n# 标题\n\n" != formatted {
+		t.Fatalf("unexpected formatted %q", formatted)
+	}
+}
+
+func TestTOCLevel(t *testing.T) {
+	luteEngine := lute.New(lute.TOC(true), lute.TOCLevel(2, 3))
+
+	html, _ := luteEngine.MarkdownStr("", "[toc]\n\n# 一\n## 二\n#### 四\n### 三\n")
+	expected := "<div class=\"toc\">\n<ul>\n<li><a href=\"#二\">二</a>\n<ul>\n<li><a href=\"#三\">三</a></li>\n</ul>\n</li>\n</ul>\n</div>\n<h1 id=\"一\">一</h1>\n<h2 id=\"二\">二</h2>\n<h4 id=\"四\">四</h4>\n<h3 id=\"三\">三</h3>\n"
+	if expected != html {
+		t.Fatalf("expected\n\t%q\ngot\n\t%q", expected, html)
+	}
+}
+
+func TestTreeTOC(t *testing.T) {
+	luteEngine := lute.New(lute.HeadingID(true))
+
+	tree, err := luteEngine.Parse("", []byte("## 跳级\n# 一 *强调*\n### 一.1\n## 一.2\n# 一 *强调*\n"))
+	if nil != err {
+		t.Fatalf("unexpected: %s", err)
+	}
+
+	data, _ := json.Marshal(tree.TOC(1, 6))
+	expected := `[{"text":"跳级","level":2,"id":"跳级"},{"text":"一 强调","level":1,"id":"一-强调","children":[{"text":"一.1","level":3,"id":"一1"},{"text":"一.2","level":2,"id":"一2"}]},{"text":"一 强调","level":1,"id":"一-强调-1"}]`
+	if expected != string(data) {
+		t.Fatalf("expected\n\t%s\ngot\n\t%s", expected, data)
+	}
+}
@@ -0,0 +1,97 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+var transformerTests = []parseTest{
+
+	{"2", "中文English [链接](/a) 和 https://b3log.org\n", "<p>中文English <a href=\"https://b3log.org/a\">链接</a> 和 <a href=\"https://b3log.org\">https://b3log.org</a></p>\n"},
+	{"1", "# 标题 :smile:\n", "<h1>标题 😄</h1>\n"},
+	{"0", "[相对](/x) [绝对](https://ld246.com)\n", "<p><a href=\"https://b3log.org/x\">相对</a> <a href=\"https://ld246.com\">绝对</a></p>\n"},
+}
+
+func TestTransformer(t *testing.T) {
+	luteEngine := lute.New()
+	luteEngine.RemoveTransformer(lute.TransformerAutoSpace)
+	// 叶子块变换：替换文本中的 :smile:
+	luteEngine.AddTransformer(&lute.Transformer{
+		Name: "smile",
+		Block: func(t *lute.Tree, block lute.Node) {
+			lute.Walk(block, func(n lute.Node, entering bool) (lute.WalkStatus, error) {
+				if entering && lute.NodeText == n.Type() {
+					n.SetTokens(bytes.Replace(n.Tokens(), []byte(":smile:"), []byte("😄"), -1))
+				}
+				return lute.WalkContinue, nil
+			})
+		},
+	})
+	// 整树变换：将相对链接改写为绝对链接
+	luteEngine.AddTransformer(&lute.Transformer{
+		Name: "absLink",
+		Tree: func(t *lute.Tree) {
+			lute.Walk(t.Root, func(n lute.Node, entering bool) (lute.WalkStatus, error) {
+				if link, ok := n.(*lute.Link); entering && ok && bytes.HasPrefix(link.Destination, []byte("/")) {
+					link.Destination = append([]byte("https://b3log.org"), link.Destination...)
+				}
+				return lute.WalkContinue, nil
+			})
+		},
+	})
+
+	for _, test := range transformerTests {
+		html, err := luteEngine.MarkdownStr(test.name, test.markdown)
+		if nil != err {
+			t.Fatalf("unexpected: %s", err)
+		}
+
+		if test.html != html {
+			t.Fatalf("test case [%s] failed\nexpected\n\t%q\ngot\n\t%q\noriginal markdown text\n\t%q", test.name, test.html, html, test.markdown)
+		}
+	}
+}
+
+func TestTransformerOrder(t *testing.T) {
+	luteEngine := lute.New(lute.AutoSpace(false))
+	luteEngine.AddTransformer(&lute.Transformer{
+		Name: "github",
+		Block: func(t *lute.Tree, block lute.Node) {
+			for n := block.FirstChild(); nil != n; n = n.Next() {
+				if lute.NodeText == n.Type() {
+					n.SetTokens(bytes.Replace(n.Tokens(), []byte("gh"), []byte("github"), -1))
+				}
+			}
+		},
+	})
+
+	// 追加在末尾时术语修正已经执行过了
+	html, _ := luteEngine.MarkdownStr("", "gh\n")
+	if "<p>github</p>\n" != html {
+		t.Fatalf("unexpected html %q", html)
+	}
+
+	// 移到术语修正之前
+	transformers := luteEngine.Transformers()
+	last := transformers[len(transformers)-1]
+	transformers = append([]*lute.Transformer{last}, transformers[:len(transformers)-1]...)
+	luteEngine.SetTransformers(transformers)
+	html, _ = luteEngine.MarkdownStr("", "gh\n")
+	if "<p>GitHub</p>\n" != html {
+		t.Fatalf("unexpected html %q", html)
+	}
+}
@@ -0,0 +1,61 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/b3log/lute"
+)
+
+func TestParseAndRender(t *testing.T) {
+	luteEngine := lute.New()
+
+	markdown := "# 标题\n\n段落*强调*\n\n## 二级标题\n"
+	tree, err := luteEngine.Parse("", []byte(markdown))
+	if nil != err {
+		t.Fatalf("parse failed: %s", err)
+	}
+
+	var levels []int
+	lute.Walk(tree.Root, func(n lute.Node, entering bool) (lute.WalkStatus, error) {
+		if entering && lute.NodeHeading == n.Type() {
+			levels = append(levels, n.(*lute.Heading).Level)
+		}
+		return lute.WalkContinue, nil
+	})
+	if 2 != len(levels) || 1 != levels[0] || 2 != levels[1] {
+		t.Fatalf("unexpected heading levels %v", levels)
+	}
+
+	// 同一颗树渲染多次
+	for i := 0; i < 2; i++ {
+		html, err := luteEngine.RenderHTML(tree)
+		if nil != err {
+			t.Fatalf("render html failed: %s", err)
+		}
+		expected, _ := luteEngine.MarkdownStr("", markdown)
+		if expected != string(html) {
+			t.Fatalf("render html failed\nexpected\n\t%q\ngot\n\t%q", expected, html)
+		}
+
+		formatted, err := luteEngine.RenderMarkdown(tree)
+		if nil != err {
+			t.Fatalf("render markdown failed: %s", err)
+		}
+		expected, _ = luteEngine.FormatStr("", markdown)
+		if expected != string(formatted) {
+			t.Fatalf("render markdown failed\nexpected\n\t%q\ngot\n\t%q", expected, formatted)
+		}
+	}
+}
@@ -21,16 +21,22 @@ type Text struct {
 	// 优化方案就是去掉嵌套的 BaseNode，将 BaseNode 的结构在 Text 中再做一次。
 	// 总的来说，不构造对象可以换来巨大的性能提升，但代价就是降低代码可读性，并且看上去会显得有些僵硬。
 
-	parent          Node   // 父节点
-	previous        Node   // 前一个兄弟节点
-	next            Node   // 后一个兄弟节点
-	firstChild      Node   // 第一个子节点
-	lastChild       Node   // 最后一个子节点
-	rawText         string // 原始内容
-	tokens          items  // 词法分析结果 tokens，语法分析阶段会继续操作这些 tokens
-	close           bool   // 标识是否关闭
-	lastLineBlank   bool   // 标识最后一行是否是空行
-	lastLineChecked bool   // 标识最后一行是否检查过
+	parent          Node     // 父节点
+	previous        Node     // 前一个兄弟节点
+	next            Node     // 后一个兄弟节点
+	firstChild      Node     // 第一个子节点
+	lastChild       Node     // 最后一个子节点
+	rawText         string   // 原始内容
+	tokens          items    // 词法分析结果 tokens，语法分析阶段会继续操作这些 tokens
+	close           bool     // 标识是否关闭
+	lastLineBlank   bool     // 标识最后一行是否是空行
+	lastLineChecked bool     // 标识最后一行是否检查过
+	pos             Position // 在原文中的位置
+}
+
+// NewText 创建一个内容为 tokens 的文本节点。
+func NewText(tokens []byte) *Text {
+	return &Text{tokens: tokens}
 }
 
 // mergeText 合并 node 中所有（包括子节点）连续的文本节点。
@@ -46,6 +52,7 @@ func (t *Tree) mergeText(node Node) {
 			// 逐个合并后续兄弟节点
 			for nil != next && NodeText == next.Type() {
 				child.AppendTokens(next.Tokens())
+				child.Position().setEnd(next.Position())
 				next.Unlink()
 				next = child.Next()
 			}
@@ -60,6 +67,10 @@ func (n *Text) Type() int {
 	return NodeText
 }
 
+func (n *Text) Position() *Position {
+	return &n.pos
+}
+
 func (n *Text) IsOpen() bool {
 	return !n.close
 }
@@ -0,0 +1,69 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"bytes"
+)
+
+// TableOfContents 描述了目录占位节点结构，由内容仅为 [toc] 的段落生成，渲染时替换为目录。
+type TableOfContents struct {
+	*BaseNode
+}
+
+// TOCItem 描述了目录项结构。
+type TOCItem struct {
+	Text     string     `json:"text"`               // 标题纯文本
+	Level    int        `json:"level"`              // 标题级别
+	ID       string     `json:"id"`                 // 标题 id，未生成标题 id 时为空
+	Children []*TOCItem `json:"children,omitempty"` // 下级目录项
+}
+
+// TOC 遍历语法树，将级别在 [minLevel, maxLevel] 之间的标题按层级组织为嵌套的目录。
+// 目录项的父节点是它前面最近的一个级别比它小的目录项，没有的话作为顶层目录项。
+func (t *Tree) TOC(minLevel, maxLevel int) []*TOCItem {
+	return toc(t.Root, minLevel, maxLevel)
+}
+
+// toc 收集以 root 为根的树中级别在 [minLevel, maxLevel] 之间的标题生成目录。
+func toc(root Node, minLevel, maxLevel int) (ret []*TOCItem) {
+	var stack []*TOCItem
+	Walk(root, func(n Node, entering bool) (WalkStatus, error) {
+		heading, ok := n.(*Heading)
+		if !entering || !ok {
+			return WalkContinue, nil
+		}
+		if heading.Level < minLevel || heading.Level > maxLevel {
+			return WalkSkipChildren, nil
+		}
+
+		item := &TOCItem{Text: nodeText(heading), Level: heading.Level, ID: fromItems(heading.ID)}
+		for 0 < len(stack) && stack[len(stack)-1].Level >= item.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if 0 < len(stack) {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, item)
+		} else {
+			ret = append(ret, item)
+		}
+		stack = append(stack, item)
+		return WalkSkipChildren, nil
+	})
+	return
+}
+
+// isTOCPlaceholder 判断段落内容 tokens 是否是目录占位 [toc]，不区分大小写。
+func isTOCPlaceholder(tokens items) bool {
+	return bytes.EqualFold(bytes.TrimSpace(tokens), []byte("[toc]"))
+}
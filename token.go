@@ -105,6 +105,9 @@ const (
 	itemAmpersand      = byte('&')
 	itemSemicolon      = byte(';')
 	itemPipe           = byte('|')
+	itemCaret          = byte('^')
+	itemDollar         = byte('$')
+	itemAt             = byte('@')
 )
 
 // items 定义了字节数组，每个字节是一个 token。
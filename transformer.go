@@ -0,0 +1,93 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+// Transformer 描述了语法树变换器，变换器在行级解析完成后、渲染之前对语法树进行处理。
+//
+// Block 和 Tree 至少需要设置一个：
+//  * Block 在每个叶子块（段落、标题和表格）完成行级解析后调用，叶子块之间是并行处理的，所以 Block 中只能修改 block 及其子节点
+//  * Tree 在整棵树完成行级解析后调用一次
+// 所有变换器的 Block 按列表顺序先执行，然后再按列表顺序执行所有变换器的 Tree。
+type Transformer struct {
+	Name  string                    // 名称，用于移除和排序，需要唯一
+	Block func(t *Tree, block Node) // 叶子块变换函数
+	Tree  func(t *Tree)             // 整树变换函数
+}
+
+// 内置变换器名称。
+const (
+	TransformerMergeText   = "mergeText"   // 合并连续的文本节点
+	TransformerGFMAutoLink = "gfmAutoLink" // GFM 自动链接
+	TransformerMentions    = "mentions"    // 提及和标签
+	TransformerAutoSpace   = "autoSpace"   // 中西文间自动插入空格
+	TransformerFixTermTypo = "fixTermTypo" // 术语拼写修正
+	TransformerHeadingID   = "headingID"   // 生成标题 id
+)
+
+// defaultTransformers 返回内置的变换器列表。内置变换器是否生效仍然由相应的选项控制。
+func defaultTransformers() []*Transformer {
+	return []*Transformer{
+		// 将连续的文本节点进行合并。
+		// 规范只是定义了从输入的 Markdown 文本到输出的 HTML 的解析渲染规则，并未定义中间语法树的规则。
+		// 也就是说语法树的节点结构没有标准，可以自行发挥。这里进行文本节点合并主要有两个目的：
+		// 1. 减少节点数量，提升后续处理性能
+		// 2. 方便后续功能方面的处理，比如 GFM 自动链接解析
+		{Name: TransformerMergeText, Block: func(t *Tree, block Node) {
+			t.mergeText(block)
+		}},
+		{Name: TransformerGFMAutoLink, Block: func(t *Tree, block Node) {
+			if t.context.option.GFMAutoLink {
+				t.parseGFMAutoEmailLink(block)
+				t.parseGFMAutoLink(block)
+			}
+		}},
+		{Name: TransformerMentions, Block: func(t *Tree, block Node) {
+			if t.context.option.Mentions || t.context.option.Hashtags {
+				t.parseMentions(block)
+			}
+		}},
+		{Name: TransformerAutoSpace, Block: func(t *Tree, block Node) {
+			if t.context.option.AutoSpace {
+				t.space(block)
+			}
+		}},
+		{Name: TransformerFixTermTypo, Block: func(t *Tree, block Node) {
+			if t.context.option.FixTermTypo {
+				t.fixTermTypo(block)
+			}
+		}},
+		{Name: TransformerHeadingID, Tree: func(t *Tree) {
+			if t.context.option.HeadingID || t.context.option.TOC {
+				t.generateHeadingIDs()
+			}
+		}},
+	}
+}
+
+// transformBlock 使用所有变换器的 Block 处理叶子块 block。
+func (t *Tree) transformBlock(block Node) {
+	for _, transformer := range t.context.option.transformers {
+		if nil != transformer.Block {
+			transformer.Block(t, block)
+		}
+	}
+}
+
+// transformTree 使用所有变换器的 Tree 处理整棵树。
+func (t *Tree) transformTree() {
+	for _, transformer := range t.context.option.transformers {
+		if nil != transformer.Tree {
+			transformer.Tree(t)
+		}
+	}
+}
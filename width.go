@@ -0,0 +1,69 @@
+// Lute - A structured markdown engine.
+// Copyright (c) 2019-present, b3log.org
+//
+// Lute is licensed under the Mulan PSL v1.
+// You can use this software according to the terms and conditions of the Mulan PSL v1.
+// You may obtain a copy of Mulan PSL v1 at:
+//     http://license.coscl.org.cn/MulanPSL
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v1 for more details.
+
+package lute
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// wideRanges 用于列出东亚宽字符（包括全角字符）和常见 Emoji 的码点范围，这些字符在等宽字体中占两列。
+// https://www.unicode.org/reports/tr11/
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // 谚文字母
+	{0x2329, 0x232A},   // 尖括号
+	{0x2E80, 0x303E},   // CJK 部首、康熙部首、CJK 符号和标点
+	{0x3041, 0x33FF},   // 平假名、片假名、注音符号等
+	{0x3400, 0x4DBF},   // CJK 统一汉字扩展 A
+	{0x4E00, 0x9FFF},   // CJK 统一汉字
+	{0xA000, 0xA4CF},   // 彝文
+	{0xAC00, 0xD7A3},   // 谚文音节
+	{0xF900, 0xFAFF},   // CJK 兼容汉字
+	{0xFE30, 0xFE4F},   // CJK 兼容形式
+	{0xFF00, 0xFF60},   // 全角 ASCII 和标点
+	{0xFFE0, 0xFFE6},   // 全角符号
+	{0x1F300, 0x1F64F}, // 杂项符号和象形文字、表情符号
+	{0x1F680, 0x1F6FF}, // 交通和地图符号
+	{0x1F900, 0x1F9FF}, // 补充符号和象形文字
+	{0x20000, 0x2FFFD}, // CJK 统一汉字扩展 B 及以后
+	{0x30000, 0x3FFFD},
+}
+
+// runeWidth 返回字符 r 在等宽字体中的显示宽度（列数）：控制字符和组合字符为 0，东亚宽字符为 2，其他为 1。
+func runeWidth(r rune) int {
+	if 0x20 > r || (0x7F <= r && 0xA0 > r) || 0x200D == r || unicode.In(r, unicode.Mn, unicode.Me, unicode.Variation_Selector) {
+		return 0
+	}
+	if 0x1100 > r {
+		return 1
+	}
+	for _, wide := range wideRanges {
+		if r < wide[0] {
+			break
+		}
+		if r <= wide[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// displayWidth 返回 UTF-8 编码的文本 tokens 在等宽字体中的显示宽度（列数）。
+func displayWidth(tokens items) (ret int) {
+	for 0 < len(tokens) {
+		r, size := utf8.DecodeRune(tokens)
+		ret += runeWidth(r)
+		tokens = tokens[size:]
+	}
+	return
+}